@@ -0,0 +1,129 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AdminPermissionsClientId is the client id of the client Keycloak creates to hold the fine-grained admin permissions (v2)
+// of a realm, once they are enabled for it
+const AdminPermissionsClientId = "admin-permissions"
+
+var AdminPermissionResourceTypeScopes = map[string][]string{
+	"Clients": {"view", "manage", "map-roles", "map-roles-client-scope", "map-roles-composite"},
+	"Groups":  {"view", "manage", "view-members", "manage-members", "manage-membership", "impersonate-members"},
+	"Roles":   {"map-role", "map-role-client-scope", "map-role-composite"},
+	"Users":   {"view", "manage", "impersonate", "map-roles", "manage-group-membership"},
+}
+
+type AdminPermission struct {
+	Id               string   `json:"id,omitempty"`
+	RealmId          string   `json:"-"`
+	ResourceServerId string   `json:"-"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	DecisionStrategy string   `json:"decisionStrategy"`
+	ResourceType     string   `json:"resourceType"`
+	Resources        []string `json:"resources"`
+	Scopes           []string `json:"scopes"`
+	Policies         []string `json:"policies"`
+	Type             string   `json:"type"`
+}
+
+// AdminPermissionsEnabled returns whether fine-grained admin permissions (v2) are enabled for the realm, which is never
+// the case before Keycloak 26.2
+func (keycloakClient *KeycloakClient) AdminPermissionsEnabled(ctx context.Context, realmId string) (bool, error) {
+	ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_26_2)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return false, err
+	}
+
+	return realm.AdminPermissionsEnabled != nil && *realm.AdminPermissionsEnabled, nil
+}
+
+func (keycloakClient *KeycloakClient) GetAdminPermissionsClient(ctx context.Context, realmId string) (*OpenidClient, error) {
+	enabled, err := keycloakClient.AdminPermissionsEnabled(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, fmt.Errorf("admin permissions are not enabled for realm %s", realmId)
+	}
+
+	return keycloakClient.GetOpenidClientByClientId(ctx, realmId, AdminPermissionsClientId)
+}
+
+func (keycloakClient *KeycloakClient) NewAdminPermission(ctx context.Context, permission *AdminPermission) error {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope", permission.RealmId, permission.ResourceServerId), permission)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, &permission)
+}
+
+func (keycloakClient *KeycloakClient) GetAdminPermission(ctx context.Context, realmId, resourceServerId, id string) (*AdminPermission, error) {
+	permission := AdminPermission{
+		RealmId:          realmId,
+		ResourceServerId: resourceServerId,
+	}
+
+	var policies []OpenidClientAuthorizationPolicy
+	var resources []OpenidClientAuthorizationResource
+	var scopes []OpenidClientAuthorizationScope
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s", realmId, resourceServerId, id), &permission, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s/associatedPolicies", realmId, resourceServerId, id), &policies, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/resources", realmId, resourceServerId, id), &resources, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/scopes", realmId, resourceServerId, id), &scopes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	permission.Policies = []string{}
+	for _, policy := range policies {
+		permission.Policies = append(permission.Policies, policy.Id)
+	}
+
+	// the resources of a permission are named after the id of the user, group, client or role they represent.
+	// permissions that apply to all resources of a type reference a single resource named after the type instead.
+	permission.Resources = []string{}
+	for _, resource := range resources {
+		if resource.Name != permission.ResourceType {
+			permission.Resources = append(permission.Resources, resource.Name)
+		}
+	}
+
+	permission.Scopes = []string{}
+	for _, scope := range scopes {
+		permission.Scopes = append(permission.Scopes, scope.Name)
+	}
+
+	return &permission, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateAdminPermission(ctx context.Context, permission *AdminPermission) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", permission.RealmId, permission.ResourceServerId, permission.Id), permission)
+}
+
+func (keycloakClient *KeycloakClient) DeleteAdminPermission(ctx context.Context, realmId, resourceServerId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s", realmId, resourceServerId, id), nil)
+}
@@ -12,8 +12,9 @@ type GenericClient struct {
 	Name     string `json:"name"`
 	Protocol string `json:"protocol"`
 
-	Enabled     bool   `json:"enabled"`
-	Description string `json:"description"`
+	Enabled          bool   `json:"enabled"`
+	Description      string `json:"description"`
+	FullScopeAllowed bool   `json:"fullScopeAllowed"`
 }
 
 func (keycloakClient *KeycloakClient) listGenericClients(ctx context.Context, realmId string) ([]*GenericClient, error) {
@@ -3,6 +3,8 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -106,6 +108,77 @@ func (keycloakClient *KeycloakClient) GetGroups(ctx context.Context, realmId str
 	return groups, nil
 }
 
+func (keycloakClient *KeycloakClient) getGroupsPaginated(ctx context.Context, path string) ([]*Group, error) {
+	var groups []*Group
+	var first, pagination = 0, 50
+	var iterationGroups []*Group
+
+	for ok := true; ok; ok = len(iterationGroups) > 0 {
+		iterationGroups = nil
+		params := map[string]string{
+			"first":               strconv.Itoa(first),
+			"max":                 strconv.Itoa(pagination),
+			"briefRepresentation": "false",
+		}
+
+		err := keycloakClient.get(ctx, path, &iterationGroups, params)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, iterationGroups...)
+		first += pagination
+	}
+
+	return groups, nil
+}
+
+// GetGroupTree returns every group within the realm as a flat list, ordered depth-first, with ParentId set for each subgroup.
+// Since Keycloak 23, subgroups are no longer part of the group representation and have to be fetched from /groups/{id}/children.
+func (keycloakClient *KeycloakClient) GetGroupTree(ctx context.Context, realmId string) ([]*Group, error) {
+	fetchChildren, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_23)
+	if err != nil {
+		return nil, err
+	}
+
+	topLevelGroups, err := keycloakClient.getGroupsPaginated(ctx, fmt.Sprintf("/realms/%s/groups", realmId))
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*Group
+
+	var walk func(subGroups []*Group, parentId string) error
+	walk = func(subGroups []*Group, parentId string) error {
+		for _, group := range subGroups {
+			group.RealmId = realmId
+			group.ParentId = parentId
+			groups = append(groups, group)
+
+			children := group.SubGroups
+			if fetchChildren {
+				children, err = keycloakClient.getGroupsPaginated(ctx, fmt.Sprintf("/realms/%s/groups/%s/children", realmId, group.Id))
+				if err != nil {
+					return err
+				}
+			}
+
+			err = walk(children, group.Id)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	err = walk(topLevelGroups, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
 func (keycloakClient *KeycloakClient) GetGroup(ctx context.Context, realmId, id string) (*Group, error) {
 	var group Group
 
@@ -165,6 +238,86 @@ func (keycloakClient *KeycloakClient) GetGroupByName(ctx context.Context, realmI
 	return nil, fmt.Errorf("no group with name " + name + " found")
 }
 
+// GetGroupByPath returns the group at the given path, such as /parent/child.
+// Servers older than Keycloak 23 don't reliably resolve nested paths through /group-by-path, so the path is resolved by walking
+// down the group tree one segment at a time instead.
+func (keycloakClient *KeycloakClient) GetGroupByPath(ctx context.Context, realmId, path string) (*Group, error) {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("validation error: group path %s is invalid, it should look like /parent/child", path)
+		}
+	}
+
+	useGroupByPath, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_23)
+	if err != nil {
+		return nil, err
+	}
+
+	if !useGroupByPath {
+		return keycloakClient.getGroupByPathSegments(ctx, realmId, path, segments)
+	}
+
+	escapedSegments := make([]string, len(segments))
+	for i, segment := range segments {
+		escapedSegments[i] = url.PathEscape(segment)
+	}
+
+	var group Group
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/group-by-path/%s", realmId, strings.Join(escapedSegments, "/")), &group, nil)
+	if err != nil {
+		if ErrorIs404(err) {
+			return nil, fmt.Errorf("no group with path %s found", path)
+		}
+
+		return nil, err
+	}
+
+	group.RealmId = realmId // it's important to set RealmId here because fetching the ParentId depends on it
+
+	parentId, err := keycloakClient.groupParentId(ctx, &group)
+	if err != nil {
+		return nil, err
+	}
+
+	group.ParentId = parentId
+
+	return &group, nil
+}
+
+func (keycloakClient *KeycloakClient) getGroupByPathSegments(ctx context.Context, realmId, path string, segments []string) (*Group, error) {
+	groups, err := keycloakClient.getGroupsPaginated(ctx, fmt.Sprintf("/realms/%s/groups", realmId))
+	if err != nil {
+		return nil, err
+	}
+
+	var group *Group
+	parentId := ""
+
+	for _, segment := range segments {
+		group = nil
+		for _, g := range groups {
+			if g.Name == segment {
+				group = g
+				break
+			}
+		}
+
+		if group == nil {
+			return nil, fmt.Errorf("no group with path %s found", path)
+		}
+
+		group.RealmId = realmId
+		group.ParentId = parentId
+
+		parentId = group.Id
+		groups = group.SubGroups
+	}
+
+	return group, nil
+}
+
 /*
 Find group by name in groups returned by /groups?search=${group_name}
 If there are multiple groups match the name, it will return the first one it found, using DFS algorithm
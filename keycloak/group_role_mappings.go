@@ -15,6 +15,10 @@ func (keycloakClient *KeycloakClient) GetGroupRoleMappings(ctx context.Context,
 	return roleMapping, nil
 }
 
+func (keycloakClient *KeycloakClient) GetGroupCompositeRoleMappings(ctx context.Context, realmId, groupId string) (*RoleMapping, error) {
+	return keycloakClient.getCompositeRoleMappings(ctx, realmId, fmt.Sprintf("/realms/%s/groups/%s/role-mappings", realmId, groupId))
+}
+
 func (keycloakClient *KeycloakClient) AddRealmRolesToGroup(ctx context.Context, realmId, groupId string, roles []*Role) error {
 	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/groups/%s/role-mappings/realm", realmId, groupId), roles)
 
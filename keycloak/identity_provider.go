@@ -2,9 +2,11 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
 	"reflect"
+	"strconv"
 )
 
 type IdentityProviderConfig struct {
@@ -93,6 +95,49 @@ func (keycloakClient *KeycloakClient) GetIdentityProvider(ctx context.Context, r
 	return &identityProvider, nil
 }
 
+// GetIdentityProviders returns every identity provider within the realm. Keycloak only supports paginating this endpoint since v24,
+// older versions ignore the pagination parameters and return every identity provider at once
+func (keycloakClient *KeycloakClient) GetIdentityProviders(ctx context.Context, realm string) ([]*IdentityProvider, error) {
+	var identityProviders []*IdentityProvider
+
+	paginated, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_24)
+	if err != nil {
+		return nil, err
+	}
+
+	if !paginated {
+		err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances", realm), &identityProviders, nil)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var first, pagination = 0, 50
+		var iterationIdentityProviders []*IdentityProvider
+
+		for ok := true; ok; ok = len(iterationIdentityProviders) > 0 {
+			iterationIdentityProviders = nil
+			params := map[string]string{
+				"first":               strconv.Itoa(first),
+				"max":                 strconv.Itoa(pagination),
+				"briefRepresentation": "false",
+			}
+
+			err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances", realm), &iterationIdentityProviders, params)
+			if err != nil {
+				return nil, err
+			}
+			identityProviders = append(identityProviders, iterationIdentityProviders...)
+			first += pagination
+		}
+	}
+
+	for _, identityProvider := range identityProviders {
+		identityProvider.Realm = realm
+	}
+
+	return identityProviders, nil
+}
+
 func (keycloakClient *KeycloakClient) UpdateIdentityProvider(ctx context.Context, identityProvider *IdentityProvider) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s", identityProvider.Realm, identityProvider.Alias), identityProvider)
 }
@@ -101,6 +146,46 @@ func (keycloakClient *KeycloakClient) DeleteIdentityProvider(ctx context.Context
 	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s", realm, alias), nil)
 }
 
+// ImportIdentityProviderConfigFromUrl lets keycloak parse the SAML metadata or OIDC discovery document found at the given url
+// into the config of an identity provider, without creating the identity provider
+func (keycloakClient *KeycloakClient) ImportIdentityProviderConfigFromUrl(ctx context.Context, realm, providerId, fromUrl string) (*IdentityProviderConfig, error) {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/identity-provider/import-config", realm), map[string]string{
+		"providerId": providerId,
+		"fromUrl":    fromUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var config IdentityProviderConfig
+	err = json.Unmarshal(body, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// ImportIdentityProviderConfigFromFile does the same as ImportIdentityProviderConfigFromUrl, for metadata that isn't published anywhere
+func (keycloakClient *KeycloakClient) ImportIdentityProviderConfigFromFile(ctx context.Context, realm, providerId string, file []byte) (*IdentityProviderConfig, error) {
+	body, err := keycloakClient.postMultipart(ctx, fmt.Sprintf("/realms/%s/identity-provider/import-config", realm), map[string]string{
+		"providerId": providerId,
+	}, map[string][]byte{
+		"file": file,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var config IdentityProviderConfig
+	err = json.Unmarshal(body, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
 func (f *IdentityProviderConfig) UnmarshalJSON(data []byte) error {
 	return unmarshalExtraConfig(data, reflect.ValueOf(f).Elem(), &f.ExtraConfig)
 }
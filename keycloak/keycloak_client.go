@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -448,6 +449,50 @@ func (keycloakClient *KeycloakClient) post(ctx context.Context, path string, req
 	return body, location, err
 }
 
+// postMultipart sends the given fields and files as multipart/form-data, which is only accepted by a couple of endpoints
+// that deal with file uploads
+func (keycloakClient *KeycloakClient) postMultipart(ctx context.Context, path string, fields map[string]string, files map[string][]byte) ([]byte, error) {
+	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+
+	var payload bytes.Buffer
+	writer := multipart.NewWriter(&payload)
+
+	for name, value := range fields {
+		err := writer.WriteField(name, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for name, content := range files {
+		part, err := writer.CreateFormFile(name, name)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = part.Write(content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, resourceUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-type", writer.FormDataContentType())
+
+	body, _, err := keycloakClient.sendRequest(ctx, request, payload.Bytes())
+
+	return body, err
+}
+
 func (keycloakClient *KeycloakClient) put(ctx context.Context, path string, requestBody interface{}) error {
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
@@ -83,6 +83,10 @@ type OpenidClientAttributes struct {
 	Oauth2DeviceCodeLifespan              string                           `json:"oauth2.device.code.lifespan,omitempty"`
 	Oauth2DevicePollingInterval           string                           `json:"oauth2.device.polling.interval,omitempty"`
 	PostLogoutRedirectUris                types.KeycloakSliceHashDelimited `json:"post.logout.redirect.uris,omitempty"`
+	JwtCredentialIssuer                   string                           `json:"jwt.credential.issuer,omitempty"`
+	JwtCredentialSubject                  string                           `json:"jwt.credential.sub,omitempty"`
+	Oauth2JwtAuthorizationGrantEnabled    types.KeycloakBoolQuoted         `json:"oauth2.jwt.authorization.grant.enabled"`
+	Oauth2JwtAuthorizationGrantIdps       types.KeycloakSliceHashDelimited `json:"oauth2.jwt.authorization.grant.idp,omitempty"`
 }
 
 type OpenidAuthenticationFlowBindingOverrides struct {
@@ -103,6 +107,19 @@ func (keycloakClient *KeycloakClient) GetOpenidClientServiceAccountUserId(ctx co
 	return &serviceAccountUser, nil
 }
 
+func (keycloakClient *KeycloakClient) GetOpenidClientInstallationProvider(ctx context.Context, realmId, id string, providerId string) ([]byte, error) {
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !serverInfo.providerInstalled("client-installation", providerId) {
+		return nil, fmt.Errorf("validation error: client installation provider \"%s\" does not exist on the server, installed providers: %s", providerId, serverInfo.getInstalledProvidersNames("client-installation"))
+	}
+
+	return keycloakClient.getRaw(ctx, fmt.Sprintf("/realms/%s/clients/%s/installation/providers/%s", realmId, id, providerId), nil)
+}
+
 func (keycloakClient *KeycloakClient) ValidateOpenidClient(ctx context.Context, client *OpenidClient) error {
 	if client.BearerOnly && (client.StandardFlowEnabled || client.ImplicitFlowEnabled || client.DirectAccessGrantsEnabled || client.ServiceAccountsEnabled) {
 		return fmt.Errorf("validation error: Keycloak cannot issue tokens for bearer-only clients; no oauth2 flows can be enabled for this client")
@@ -125,6 +142,22 @@ func (keycloakClient *KeycloakClient) ValidateOpenidClient(ctx context.Context,
 		return fmt.Errorf("validation error: theme \"%s\" does not exist on the server", client.Attributes.LoginTheme)
 	}
 
+	if client.ClientAuthenticatorType == "federated-jwt" || client.Attributes.JwtCredentialIssuer != "" {
+		if ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_26_4); err != nil {
+			return err
+		} else if !ok {
+			return fmt.Errorf("validation error: federated client authentication requires Keycloak v26.4 or higher")
+		}
+	}
+
+	if client.Attributes.Oauth2JwtAuthorizationGrantEnabled {
+		if ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_26_5); err != nil {
+			return err
+		} else if !ok {
+			return fmt.Errorf("validation error: the JWT authorization grant requires Keycloak v26.5 or higher")
+		}
+	}
+
 	return nil
 }
 
@@ -180,6 +213,17 @@ func (keycloakClient *KeycloakClient) GetOpenidClients(ctx context.Context, real
 	return clients, nil
 }
 
+func (keycloakClient *KeycloakClient) GetOpenidClientSecret(ctx context.Context, realmId, id string) (*OpenidClientSecret, error) {
+	var clientSecret OpenidClientSecret
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/client-secret", realmId, id), &clientSecret, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientSecret, nil
+}
+
 func (keycloakClient *KeycloakClient) GetOpenidClient(ctx context.Context, realmId, id string) (*OpenidClient, error) {
 	var client OpenidClient
 	var clientSecret OpenidClientSecret
@@ -343,6 +387,57 @@ func (keycloakClient *KeycloakClient) DetachOpenidClientOptionalScopes(ctx conte
 	return keycloakClient.detachOpenidClientScopes(ctx, realmId, clientId, "optional", scopeNames)
 }
 
+func (keycloakClient *KeycloakClient) attachOpenidClientScope(ctx context.Context, realmId, clientId, t, clientScopeId string) error {
+	openidClient, err := keycloakClient.GetOpenidClient(ctx, realmId, clientId)
+	if err != nil && ErrorIs404(err) {
+		return fmt.Errorf("validation error: client with id %s does not exist", clientId)
+	} else if err != nil {
+		return err
+	}
+
+	if openidClient.BearerOnly {
+		return fmt.Errorf("validation error: client with id %s uses access type BEARER-ONLY which does not use scopes", clientId)
+	}
+
+	var attachedClientScopes []*OpenidClientScope
+	var duplicateScopeAssignmentErrorMessage string
+	switch t {
+	case "optional":
+		attachedClientScopes, err = keycloakClient.GetOpenidClientDefaultScopes(ctx, realmId, clientId)
+		duplicateScopeAssignmentErrorMessage = "validation error: scope %s is already attached to client as a default scope"
+	case "default":
+		attachedClientScopes, err = keycloakClient.GetOpenidClientOptionalScopes(ctx, realmId, clientId)
+		duplicateScopeAssignmentErrorMessage = "validation error: scope %s is already attached to client as an optional scope"
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, attachedClientScope := range attachedClientScopes {
+		if attachedClientScope.Id == clientScopeId {
+			return fmt.Errorf(duplicateScopeAssignmentErrorMessage, attachedClientScope.Name)
+		}
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/%s-client-scopes/%s", realmId, clientId, t, clientScopeId), nil)
+}
+
+func (keycloakClient *KeycloakClient) AttachOpenidClientDefaultScope(ctx context.Context, realmId, clientId, clientScopeId string) error {
+	return keycloakClient.attachOpenidClientScope(ctx, realmId, clientId, "default", clientScopeId)
+}
+
+func (keycloakClient *KeycloakClient) AttachOpenidClientOptionalScope(ctx context.Context, realmId, clientId, clientScopeId string) error {
+	return keycloakClient.attachOpenidClientScope(ctx, realmId, clientId, "optional", clientScopeId)
+}
+
+func (keycloakClient *KeycloakClient) DetachOpenidClientDefaultScope(ctx context.Context, realmId, clientId, clientScopeId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s/default-client-scopes/%s", realmId, clientId, clientScopeId), nil)
+}
+
+func (keycloakClient *KeycloakClient) DetachOpenidClientOptionalScope(ctx context.Context, realmId, clientId, clientScopeId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s/optional-client-scopes/%s", realmId, clientId, clientScopeId), nil)
+}
+
 func (f *OpenidClientAttributes) UnmarshalJSON(data []byte) error {
 	return unmarshalExtraConfig(data, reflect.ValueOf(f).Elem(), &f.ExtraConfig)
 }
@@ -0,0 +1,56 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// PartialImportUser is the representation of a user within a partial import, which allows groups and realm roles to be
+// assigned to the user as part of its creation.
+type PartialImportUser struct {
+	*User
+	Groups     []string `json:"groups,omitempty"`
+	RealmRoles []string `json:"realmRoles,omitempty"`
+}
+
+type PartialImport struct {
+	IfResourceExists string               `json:"ifResourceExists"`
+	Users            []*PartialImportUser `json:"users,omitempty"`
+}
+
+type PartialImportResult struct {
+	Action       string `json:"action"`
+	ResourceType string `json:"resourceType"`
+	ResourceName string `json:"resourceName"`
+	Id           string `json:"id"`
+}
+
+type PartialImportResponse struct {
+	Added       int                    `json:"added"`
+	Skipped     int                    `json:"skipped"`
+	Overwritten int                    `json:"overwritten"`
+	Results     []*PartialImportResult `json:"results"`
+}
+
+// PartialImportUsers creates the given users within a single request. ifResourceExists controls what happens to users that
+// already exist, and should be one of FAIL, SKIP or OVERWRITE.
+func (keycloakClient *KeycloakClient) PartialImportUsers(ctx context.Context, realmId string, users []*PartialImportUser, ifResourceExists string) (*PartialImportResponse, error) {
+	partialImport := &PartialImport{
+		IfResourceExists: ifResourceExists,
+		Users:            users,
+	}
+
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/partialImport", realmId), partialImport)
+	if err != nil {
+		return nil, err
+	}
+
+	var response PartialImportResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
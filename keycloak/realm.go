@@ -23,13 +23,14 @@ type Keys struct {
 }
 
 type Realm struct {
-	Id                   string `json:"id,omitempty"`
-	Realm                string `json:"realm"`
-	Enabled              bool   `json:"enabled"`
-	DisplayName          string `json:"displayName"`
-	DisplayNameHtml      string `json:"displayNameHtml"`
-	UserManagedAccess    bool   `json:"userManagedAccessAllowed"`
-	OrganizationsEnabled bool   `json:"organizationsEnabled,omitempty"`
+	Id                      string `json:"id,omitempty"`
+	Realm                   string `json:"realm"`
+	Enabled                 bool   `json:"enabled"`
+	DisplayName             string `json:"displayName"`
+	DisplayNameHtml         string `json:"displayNameHtml"`
+	UserManagedAccess       bool   `json:"userManagedAccessAllowed"`
+	OrganizationsEnabled    bool   `json:"organizationsEnabled,omitempty"`
+	AdminPermissionsEnabled *bool  `json:"adminPermissionsEnabled,omitempty"`
 
 	// Login Config
 	RegistrationAllowed         bool   `json:"registrationAllowed"`
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"net/url"
+	"strconv"
 )
 
 type Role struct {
@@ -102,6 +103,24 @@ func (keycloakClient *KeycloakClient) GetClientRoles(ctx context.Context, realmI
 	return roles, nil
 }
 
+// GetRoles returns the realm roles, or the roles of the client when clientId is set. Unlike GetRealmRoles and GetClientRoles,
+// the full representation of every role is fetched, which includes its attributes.
+func (keycloakClient *KeycloakClient) GetRoles(ctx context.Context, realmId, clientId string) ([]*Role, error) {
+	var roles []*Role
+
+	err := keycloakClient.get(ctx, roleByNameUrl(realmId, clientId), &roles, map[string]string{"briefRepresentation": "false"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		role.RealmId = realmId
+		role.ClientId = clientId
+	}
+
+	return roles, nil
+}
+
 func (keycloakClient *KeycloakClient) GetClientRoleUsers(ctx context.Context, realmId string, roles []*Role) (*[]UsersInRole, error) {
 	var usersInRoles []UsersInRole
 
@@ -123,6 +142,120 @@ func (keycloakClient *KeycloakClient) GetClientRoleUsers(ctx context.Context, re
 	return &usersInRoles, nil
 }
 
+// GetRoleUsers returns the users the role is directly assigned to. Users that hold the role through a group or a composite role are not included.
+func (keycloakClient *KeycloakClient) GetRoleUsers(ctx context.Context, role *Role) ([]*User, error) {
+	var users []*User
+	var first, pagination = 0, 100
+	var iterationUsers []*User
+
+	for ok := true; ok; ok = len(iterationUsers) > 0 {
+		iterationUsers = nil
+		params := map[string]string{
+			"first": strconv.Itoa(first),
+			"max":   strconv.Itoa(pagination),
+		}
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("%s/%s/users", roleByNameUrl(role.RealmId, role.ClientId), url.PathEscape(role.Name)), &iterationUsers, params)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, iterationUsers...)
+		first += pagination
+	}
+
+	for _, user := range users {
+		user.RealmId = role.RealmId
+	}
+
+	return users, nil
+}
+
+// GetRoleGroups returns the groups the role is directly assigned to. Subgroups of these groups are not included.
+func (keycloakClient *KeycloakClient) GetRoleGroups(ctx context.Context, role *Role) ([]*Group, error) {
+	var groups []*Group
+	var first, pagination = 0, 100
+	var iterationGroups []*Group
+
+	for ok := true; ok; ok = len(iterationGroups) > 0 {
+		iterationGroups = nil
+		params := map[string]string{
+			"first": strconv.Itoa(first),
+			"max":   strconv.Itoa(pagination),
+		}
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("%s/%s/groups", roleByNameUrl(role.RealmId, role.ClientId), url.PathEscape(role.Name)), &iterationGroups, params)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, iterationGroups...)
+		first += pagination
+	}
+
+	for _, group := range groups {
+		group.RealmId = role.RealmId
+	}
+
+	return groups, nil
+}
+
+// GetRoleParents returns the realm and client roles that contain the role, either as one of their own composites or
+// through one of their composites. Keycloak doesn't offer a way to look these up, so the composites of every composite
+// role within the realm are fetched.
+func (keycloakClient *KeycloakClient) GetRoleParents(ctx context.Context, role *Role) ([]*Role, error) {
+	realmRoles, err := keycloakClient.GetRealmRoles(ctx, role.RealmId)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := keycloakClient.GetOpenidClients(ctx, role.RealmId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	clientRoles, err := keycloakClient.GetClientRoles(ctx, role.RealmId, clients)
+	if err != nil {
+		return nil, err
+	}
+
+	// maps the id of every role to the composite roles it is a direct composite of
+	directParents := map[string][]*Role{}
+	for _, candidate := range append(realmRoles, clientRoles...) {
+		if !candidate.Composite {
+			continue
+		}
+
+		composites, err := keycloakClient.GetRoleComposites(ctx, candidate)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, composite := range composites {
+			directParents[composite.Id] = append(directParents[composite.Id], candidate)
+		}
+	}
+
+	var parents []*Role
+	visited := map[string]bool{role.Id: true}
+	queue := []string{role.Id}
+
+	for len(queue) != 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, parent := range directParents[current] {
+			if !visited[parent.Id] {
+				visited[parent.Id] = true
+				parents = append(parents, parent)
+				queue = append(queue, parent.Id)
+			}
+		}
+	}
+
+	return parents, nil
+}
+
 func (keycloakClient *KeycloakClient) GetRole(ctx context.Context, realmId, id string) (*Role, error) {
 	var role Role
 	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/roles-by-id/%s", realmId, id), &role, nil)
@@ -202,3 +335,43 @@ func (keycloakClient *KeycloakClient) GetRoleComposites(ctx context.Context, rol
 
 	return composites, nil
 }
+
+// ValidateRoleComposites makes sure that adding compositeRoles as composites of role doesn't create a cycle, which is the case
+// when role can already be reached by following the composites of one of them. Realm and client roles are both followed.
+func (keycloakClient *KeycloakClient) ValidateRoleComposites(ctx context.Context, role *Role, compositeRoles []*Role) error {
+	for _, compositeRole := range compositeRoles {
+		if compositeRole.Id == role.Id {
+			return fmt.Errorf("validation error: role %s cannot be a composite of itself", role.Name)
+		}
+
+		visited := map[string]bool{compositeRole.Id: true}
+		queue := []*Role{compositeRole}
+
+		for len(queue) != 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			if !current.Composite {
+				continue
+			}
+
+			composites, err := keycloakClient.GetRoleComposites(ctx, &Role{RealmId: role.RealmId, Id: current.Id})
+			if err != nil {
+				return err
+			}
+
+			for _, composite := range composites {
+				if composite.Id == role.Id {
+					return fmt.Errorf("validation error: adding role %s as a composite of role %s would create a cycle, since role %s is already a composite of role %s", compositeRole.Name, role.Name, role.Name, compositeRole.Name)
+				}
+
+				if !visited[composite.Id] {
+					visited[composite.Id] = true
+					queue = append(queue, composite)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// getCompositeRoleMappings returns the effective role mappings of a user or group, identified by the url of its role mappings.
+// Unlike the role mappings endpoint, the composite endpoints include the roles that are granted through composite roles,
+// groups and parent groups. Keycloak only offers them per client, so every client within the realm is checked.
+func (keycloakClient *KeycloakClient) getCompositeRoleMappings(ctx context.Context, realmId, roleMappingsUrl string) (*RoleMapping, error) {
+	roleMapping := &RoleMapping{
+		ClientMappings: map[string]*ClientRoleMapping{},
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("%s/realm/composite", roleMappingsUrl), &roleMapping.RealmMappings, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := keycloakClient.GetOpenidClients(ctx, realmId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, client := range clients {
+		var compositeClientRoles []*Role
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("%s/clients/%s/composite", roleMappingsUrl, client.Id), &compositeClientRoles, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(compositeClientRoles) == 0 {
+			continue
+		}
+
+		roleMapping.ClientMappings[client.ClientId] = &ClientRoleMapping{
+			Client:   client.ClientId,
+			Id:       client.Id,
+			Mappings: compositeClientRoles,
+		}
+	}
+
+	return roleMapping, nil
+}
@@ -77,3 +77,42 @@ func (keycloakClient *KeycloakClient) DeleteRoleScopeMapping(ctx context.Context
 		return keycloakClient.delete(ctx, roleUrl, body)
 	}
 }
+
+func roleScopeMappingsUrl(realmId, clientId, clientScopeId string) string {
+	if clientId != "" {
+		return fmt.Sprintf("/realms/%s/clients/%s/scope-mappings", realmId, clientId)
+	}
+
+	return fmt.Sprintf("/realms/%s/client-scopes/%s/scope-mappings", realmId, clientScopeId)
+}
+
+func (keycloakClient *KeycloakClient) GetRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId string) (*RoleMapping, error) {
+	var roleMapping *RoleMapping
+
+	err := keycloakClient.get(ctx, roleScopeMappingsUrl(realmId, clientId, clientScopeId), &roleMapping, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return roleMapping, nil
+}
+
+func (keycloakClient *KeycloakClient) AddRealmRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId string, roles []*Role) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("%s/realm", roleScopeMappingsUrl(realmId, clientId, clientScopeId)), roles)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) AddClientRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId, roleClientId string, roles []*Role) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("%s/clients/%s", roleScopeMappingsUrl(realmId, clientId, clientScopeId), roleClientId), roles)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) RemoveRealmRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId string, roles []*Role) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("%s/realm", roleScopeMappingsUrl(realmId, clientId, clientScopeId)), roles)
+}
+
+func (keycloakClient *KeycloakClient) RemoveClientRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId, roleClientId string, roles []*Role) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("%s/clients/%s", roleScopeMappingsUrl(realmId, clientId, clientScopeId), roleClientId), roles)
+}
@@ -3,6 +3,8 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
 type FederatedIdentity struct {
@@ -26,6 +28,7 @@ type User struct {
 	Attributes          map[string][]string `json:"attributes"`
 	FederatedIdentities FederatedIdentities `json:"federatedIdentities"`
 	RequiredActions     []string            `json:"requiredActions"`
+	Credentials         []*UserCredential   `json:"credentials,omitempty"`
 }
 
 type PasswordCredentials struct {
@@ -46,6 +49,7 @@ func (keycloakClient *KeycloakClient) NewUser(ctx context.Context, user *User) e
 		Enabled:         user.Enabled,
 		Attributes:      user.Attributes,
 		RequiredActions: user.RequiredActions,
+		Credentials:     user.Credentials,
 	}
 	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users", user.RealmId), newUser)
 	if err != nil {
@@ -55,7 +59,7 @@ func (keycloakClient *KeycloakClient) NewUser(ctx context.Context, user *User) e
 	user.Id = getIdFromLocationHeader(location)
 
 	for _, federatedIdentity := range user.FederatedIdentities {
-		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), federatedIdentity)
+		err := keycloakClient.CreateUserFederatedIdentity(ctx, user.RealmId, user.Id, federatedIdentity)
 		if err != nil {
 			return err
 		}
@@ -78,12 +82,57 @@ func (keycloakClient *KeycloakClient) ResetUserPassword(ctx context.Context, rea
 	return nil
 }
 
+// ExecuteUserActionsEmail sends an email to the user, containing a link that allows them to perform the given required actions.
+// lifespan is the number of seconds the link is valid for; keycloak's default is used when it is 0.
+// Keycloak rejects required actions that aren't enabled within the realm.
+func (keycloakClient *KeycloakClient) ExecuteUserActionsEmail(ctx context.Context, realmId, userId string, actions []string, lifespan int, clientId, redirectUri string) error {
+	params := url.Values{}
+	if lifespan != 0 {
+		params.Set("lifespan", strconv.Itoa(lifespan))
+	}
+	if clientId != "" {
+		params.Set("client_id", clientId)
+	}
+	if redirectUri != "" {
+		params.Set("redirect_uri", redirectUri)
+	}
+
+	path := fmt.Sprintf("/realms/%s/users/%s/execute-actions-email", realmId, userId)
+	if len(params) != 0 {
+		path = path + "?" + params.Encode()
+	}
+
+	return keycloakClient.put(ctx, path, actions)
+}
+
 func (keycloakClient *KeycloakClient) GetUsers(ctx context.Context, realmId string) ([]*User, error) {
+	return keycloakClient.SearchUsers(ctx, realmId, nil)
+}
+
+// SearchUsers pages through every user in the realm that matches the given query parameters, such as
+// search, email, exact, q, enabled, emailVerified or idpAlias
+func (keycloakClient *KeycloakClient) SearchUsers(ctx context.Context, realmId string, params map[string]string) ([]*User, error) {
 	var users []*User
+	var first, pagination = 0, 50
+	var iterationUsers []*User
 
-	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users", realmId), &users, nil)
-	if err != nil {
-		return nil, err
+	query := map[string]string{
+		"max": strconv.Itoa(pagination),
+	}
+	for k, v := range params {
+		query[k] = v
+	}
+
+	for ok := true; ok; ok = len(iterationUsers) > 0 {
+		iterationUsers = nil
+		query["first"] = strconv.Itoa(first)
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users", realmId), &iterationUsers, query)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, iterationUsers...)
+		first += pagination
 	}
 
 	for _, user := range users {
@@ -107,23 +156,68 @@ func (keycloakClient *KeycloakClient) GetUser(ctx context.Context, realmId, id s
 }
 
 func (keycloakClient *KeycloakClient) UpdateUser(ctx context.Context, user *User) error {
-	err := keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/%s", user.RealmId, user.Id), user)
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/%s", user.RealmId, user.Id), user)
+}
+
+func (keycloakClient *KeycloakClient) GetUserFederatedIdentities(ctx context.Context, realmId, userId string) (FederatedIdentities, error) {
+	var federatedIdentities FederatedIdentities
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", realmId, userId), &federatedIdentities, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var federatedIdentities []*FederatedIdentity
-	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", user.RealmId, user.Id), &federatedIdentities, nil)
+	return federatedIdentities, nil
+}
+
+func (keycloakClient *KeycloakClient) CreateUserFederatedIdentity(ctx context.Context, realmId, userId string, federatedIdentity *FederatedIdentity) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, federatedIdentity.IdentityProvider), federatedIdentity)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) DeleteUserFederatedIdentity(ctx context.Context, realmId, userId, identityProvider string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, identityProvider), nil)
+}
+
+// UpdateUserFederatedIdentities makes sure that the user is linked to exactly the given federated identities.
+// Links that are already up-to-date are left alone, so users aren't unlinked from their identity provider unless required.
+func (keycloakClient *KeycloakClient) UpdateUserFederatedIdentities(ctx context.Context, realmId, userId string, federatedIdentities FederatedIdentities) error {
+	existingFederatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, realmId, userId)
 	if err != nil {
 		return err
 	}
 
+	existingByIdentityProvider := make(map[string]*FederatedIdentity)
+	for _, existingFederatedIdentity := range existingFederatedIdentities {
+		existingByIdentityProvider[existingFederatedIdentity.IdentityProvider] = existingFederatedIdentity
+	}
+
+	requestedByIdentityProvider := make(map[string]*FederatedIdentity)
 	for _, federatedIdentity := range federatedIdentities {
-		keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), nil)
+		requestedByIdentityProvider[federatedIdentity.IdentityProvider] = federatedIdentity
 	}
 
-	for _, federatedIdentity := range user.FederatedIdentities {
-		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), federatedIdentity)
+	// links can't be updated in place, so links that have changed need to be removed before they can be created again
+	for identityProvider, existingFederatedIdentity := range existingByIdentityProvider {
+		requestedFederatedIdentity, ok := requestedByIdentityProvider[identityProvider]
+		if ok && requestedFederatedIdentity.UserId == existingFederatedIdentity.UserId && requestedFederatedIdentity.UserName == existingFederatedIdentity.UserName {
+			continue
+		}
+
+		err = keycloakClient.DeleteUserFederatedIdentity(ctx, realmId, userId, identityProvider)
+		if err != nil {
+			return err
+		}
+		delete(existingByIdentityProvider, identityProvider)
+	}
+
+	for identityProvider, federatedIdentity := range requestedByIdentityProvider {
+		if _, ok := existingByIdentityProvider[identityProvider]; ok {
+			continue
+		}
+
+		err = keycloakClient.CreateUserFederatedIdentity(ctx, realmId, userId, federatedIdentity)
 		if err != nil {
 			return err
 		}
@@ -163,12 +257,27 @@ func (keycloakClient *KeycloakClient) GetUserByUsername(ctx context.Context, rea
 	return nil, nil
 }
 
+// GetUserGroups returns every group the user is a direct member of. The groups are fetched page by page, since keycloak
+// only returns the first 100 groups by default.
 func (keycloakClient *KeycloakClient) GetUserGroups(ctx context.Context, realmId, userId string) ([]*Group, error) {
 	var groups []*Group
-	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/groups/", realmId, userId), &groups, nil)
+	var first, pagination = 0, 100
+	var iterationGroups []*Group
+
+	for ok := true; ok; ok = len(iterationGroups) == pagination {
+		iterationGroups = nil
+		params := map[string]string{
+			"first": strconv.Itoa(first),
+			"max":   strconv.Itoa(pagination),
+		}
 
-	if err != nil {
-		return nil, err
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/groups", realmId, userId), &iterationGroups, params)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, iterationGroups...)
+		first += pagination
 	}
 
 	return groups, nil
@@ -247,3 +356,73 @@ func (keycloakClient *KeycloakClient) RemoveUserFromGroups(ctx context.Context,
 	}
 	return nil
 }
+
+type UserSession struct {
+	Id         string            `json:"id"`
+	Username   string            `json:"username"`
+	UserId     string            `json:"userId"`
+	IpAddress  string            `json:"ipAddress"`
+	Start      int64             `json:"start"`
+	LastAccess int64             `json:"lastAccess"`
+	RememberMe bool              `json:"rememberMe"`
+	Clients    map[string]string `json:"clients"`
+}
+
+func (keycloakClient *KeycloakClient) GetUserSessions(ctx context.Context, realmId, userId string) ([]*UserSession, error) {
+	var sessions []*UserSession
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/sessions", realmId, userId), &sessions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (keycloakClient *KeycloakClient) GetUserOfflineSessions(ctx context.Context, realmId, userId, clientId string) ([]*UserSession, error) {
+	var sessions []*UserSession
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/offline-sessions/%s", realmId, userId, clientId), &sessions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (keycloakClient *KeycloakClient) getClientSessions(ctx context.Context, realmId, clientId, t string) ([]*UserSession, error) {
+	var sessions []*UserSession
+	var first, pagination = 0, 50
+	var iterationSessions []*UserSession
+
+	for ok := true; ok; ok = len(iterationSessions) > 0 {
+		iterationSessions = nil
+		params := map[string]string{
+			"first": strconv.Itoa(first),
+			"max":   strconv.Itoa(pagination),
+		}
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/%s", realmId, clientId, t), &iterationSessions, params)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, iterationSessions...)
+		first += pagination
+	}
+
+	return sessions, nil
+}
+
+func (keycloakClient *KeycloakClient) GetClientUserSessions(ctx context.Context, realmId, clientId string) ([]*UserSession, error) {
+	return keycloakClient.getClientSessions(ctx, realmId, clientId, "user-sessions")
+}
+
+func (keycloakClient *KeycloakClient) GetClientOfflineSessions(ctx context.Context, realmId, clientId string) ([]*UserSession, error) {
+	return keycloakClient.getClientSessions(ctx, realmId, clientId, "offline-sessions")
+}
+
+// LogoutUser revokes every active session of the user. Offline sessions are not affected
+func (keycloakClient *KeycloakClient) LogoutUser(ctx context.Context, realmId, userId string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/logout", realmId, userId), nil)
+
+	return err
+}
@@ -0,0 +1,172 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type UserCredential struct {
+	Id             string `json:"id,omitempty"`
+	Type           string `json:"type"`
+	UserLabel      string `json:"userLabel,omitempty"`
+	CreatedDate    int64  `json:"createdDate,omitempty"`
+	Priority       int    `json:"priority,omitempty"`
+	SecretData     string `json:"secretData,omitempty"`
+	CredentialData string `json:"credentialData,omitempty"`
+	Value          string `json:"value,omitempty"`
+	Temporary      bool   `json:"temporary,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) GetUserCredentials(ctx context.Context, realmId, userId string) ([]*UserCredential, error) {
+	var credentials []*UserCredential
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/credentials", realmId, userId), &credentials, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials, nil
+}
+
+func (keycloakClient *KeycloakClient) GetUserCredential(ctx context.Context, realmId, userId, credentialId string) (*UserCredential, error) {
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, credential := range credentials {
+		if credential.Id == credentialId {
+			return credential, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateUserCredentials stores the given credentials for the user as they are. Keycloak doesn't offer a dedicated endpoint
+// for this, so the credentials are sent as a user representation that only contains the credentials, which keycloak merges
+// into the existing user.
+func (keycloakClient *KeycloakClient) CreateUserCredentials(ctx context.Context, realmId, userId string, credentials []*UserCredential) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/%s", realmId, userId), map[string]interface{}{
+		"credentials": credentials,
+	})
+}
+
+func (keycloakClient *KeycloakClient) DeleteUserCredential(ctx context.Context, realmId, userId, credentialId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/credentials/%s", realmId, userId, credentialId), nil)
+}
+
+// DeleteUserCredentialsByType removes every credential of the given type, such as "otp" or "password", from the user
+func (keycloakClient *KeycloakClient) DeleteUserCredentialsByType(ctx context.Context, realmId, userId, credentialType string) error {
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	for _, credential := range credentials {
+		if credential.Type != credentialType {
+			continue
+		}
+
+		err = keycloakClient.DeleteUserCredential(ctx, realmId, userId, credential.Id)
+		if err != nil && !ErrorIs404(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidatePasswordHashingAlgorithm makes sure that a password hashing provider for the given algorithm is installed on the server
+func (keycloakClient *KeycloakClient) ValidatePasswordHashingAlgorithm(ctx context.Context, algorithm string) error {
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !serverInfo.providerInstalled("password-hashing", algorithm) {
+		return fmt.Errorf("validation error: password hashing algorithm \"%s\" does not exist on the server, installed providers: %s", algorithm, serverInfo.getInstalledProvidersNames("password-hashing"))
+	}
+
+	return nil
+}
+
+type passwordSecretData struct {
+	Value                string            `json:"value"`
+	Salt                 string            `json:"salt,omitempty"`
+	AdditionalParameters map[string]string `json:"additionalParameters"`
+}
+
+type passwordCredentialData struct {
+	HashIterations       int               `json:"hashIterations"`
+	Algorithm            string            `json:"algorithm"`
+	AdditionalParameters map[string]string `json:"additionalParameters"`
+}
+
+// NewHashedPasswordCredential builds a password credential from a hash that has been computed outside of keycloak, i.e. when
+// migrating users from a legacy system. salt is expected to be base64 encoded.
+func NewHashedPasswordCredential(hashedSaltedValue, salt, algorithm string, hashIterations int) (*UserCredential, error) {
+	secretData, err := json.Marshal(passwordSecretData{
+		Value:                hashedSaltedValue,
+		Salt:                 salt,
+		AdditionalParameters: map[string]string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credentialData, err := json.Marshal(passwordCredentialData{
+		HashIterations:       hashIterations,
+		Algorithm:            algorithm,
+		AdditionalParameters: map[string]string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserCredential{
+		Type:           "password",
+		SecretData:     string(secretData),
+		CredentialData: string(credentialData),
+	}, nil
+}
+
+type otpSecretData struct {
+	Value string `json:"value"`
+}
+
+type otpCredentialData struct {
+	SubType   string `json:"subType"`
+	Digits    int    `json:"digits"`
+	Counter   int    `json:"counter"`
+	Period    int    `json:"period"`
+	Algorithm string `json:"algorithm"`
+}
+
+// NewOtpCredential builds an otp credential for the given secret. subType is either "totp" or "hotp".
+func NewOtpCredential(secret, subType, algorithm string, digits, period, counter int) (*UserCredential, error) {
+	secretData, err := json.Marshal(otpSecretData{
+		Value: secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credentialData, err := json.Marshal(otpCredentialData{
+		SubType:   subType,
+		Digits:    digits,
+		Counter:   counter,
+		Period:    period,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserCredential{
+		Type:           "otp",
+		SecretData:     string(secretData),
+		CredentialData: string(credentialData),
+	}, nil
+}
@@ -15,6 +15,10 @@ func (keycloakClient *KeycloakClient) GetUserRoleMappings(ctx context.Context, r
 	return roleMapping, nil
 }
 
+func (keycloakClient *KeycloakClient) GetUserCompositeRoleMappings(ctx context.Context, realmId, userId string) (*RoleMapping, error) {
+	return keycloakClient.getCompositeRoleMappings(ctx, realmId, fmt.Sprintf("/realms/%s/users/%s/role-mappings", realmId, userId))
+}
+
 func (keycloakClient *KeycloakClient) AddRealmRolesToUser(ctx context.Context, realmId, userId string, roles []*Role) error {
 	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/role-mappings/realm", realmId, userId), roles)
 
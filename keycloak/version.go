@@ -30,6 +30,9 @@ const (
 	Version_25   Version = "25.0.0"
 	Version_26   Version = "26.0.0"
 	Version_26_1 Version = "26.1.0"
+	Version_26_2 Version = "26.2.0"
+	Version_26_4 Version = "26.4.0"
+	Version_26_5 Version = "26.5.0"
 )
 
 func (v Version) AsVersion() *version.Version {
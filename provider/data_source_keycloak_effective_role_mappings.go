@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakEffectiveRoleMappings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakEffectiveRoleMappingsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"user_id", "group_id", "service_account_client_id"},
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"user_id", "group_id", "service_account_client_id"},
+			},
+			"service_account_client_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"user_id", "group_id", "service_account_client_id"},
+				Description:  "The id of a client with service accounts enabled. The role mappings of its service account user are returned.",
+			},
+			"realm_roles": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"client_roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"roles": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakEffectiveRoleMappingsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	var id string
+	var roleMapping *keycloak.RoleMapping
+	var err error
+
+	if groupId, ok := data.GetOk("group_id"); ok {
+		id = groupId.(string)
+		roleMapping, err = keycloakClient.GetGroupCompositeRoleMappings(ctx, realmId, id)
+	} else {
+		var userId string
+		if clientId, ok := data.GetOk("service_account_client_id"); ok {
+			serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(ctx, realmId, clientId.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			id, userId = clientId.(string), serviceAccountUser.Id
+		} else {
+			userId = data.Get("user_id").(string)
+			id = userId
+		}
+
+		roleMapping, err = keycloakClient.GetUserCompositeRoleMappings(ctx, realmId, userId)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var realmRoles []string
+	for _, role := range roleMapping.RealmMappings {
+		realmRoles = append(realmRoles, role.Name)
+	}
+
+	// the client mappings are keyed by the client id, sort them to keep the output stable
+	var clientIds []string
+	for clientId := range roleMapping.ClientMappings {
+		clientIds = append(clientIds, clientId)
+	}
+	sort.Strings(clientIds)
+
+	var clientRoles []interface{}
+	for _, clientId := range clientIds {
+		clientRoleMapping := roleMapping.ClientMappings[clientId]
+
+		var roles []string
+		for _, role := range clientRoleMapping.Mappings {
+			roles = append(roles, role.Name)
+		}
+
+		clientRoles = append(clientRoles, map[string]interface{}{
+			"id":        clientRoleMapping.Id,
+			"client_id": clientRoleMapping.Client,
+			"roles":     roles,
+		})
+	}
+
+	data.SetId(realmId + "/" + id)
+	data.Set("realm_roles", realmRoles)
+	data.Set("client_roles", clientRoles)
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceEffectiveRoleMappings_basic(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakEffectiveRoleMappings_basic(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					// the user holds the roles through its group, and the client roles through the composite realm role
+					resource.TestCheckTypeSetElemAttr("data.keycloak_effective_role_mappings.user", "realm_roles.*", prefix+"-editor"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_effective_role_mappings.user", "client_roles.*", map[string]string{
+						"client_id": prefix + "-api",
+						"roles.#":   "2",
+					}),
+					resource.TestCheckResourceAttr("data.keycloak_effective_role_mappings.group", "realm_roles.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_effective_role_mappings.group", "client_roles.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_effective_role_mappings.group", "client_roles.0.client_id", prefix+"-api"),
+					resource.TestCheckResourceAttrPair("data.keycloak_effective_role_mappings.group", "client_roles.0.id", "keycloak_openid_client.api", "id"),
+					resource.TestCheckTypeSetElemAttr("data.keycloak_effective_role_mappings.group", "client_roles.0.roles.*", "read"),
+					resource.TestCheckTypeSetElemAttr("data.keycloak_effective_role_mappings.group", "client_roles.0.roles.*", "write"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_effective_role_mappings.service_account", "client_roles.*", map[string]string{
+						"client_id": prefix + "-api",
+						"roles.#":   "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakEffectiveRoleMappings_basic(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "api" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s-api"
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_role" "read" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.api.id
+	name      = "read"
+}
+
+resource "keycloak_role" "write" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.api.id
+	name      = "write"
+}
+
+resource "keycloak_role" "editor" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-editor"
+
+	composite_roles = [
+		keycloak_role.read.id,
+		keycloak_role.write.id,
+	]
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_group_roles" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.group.id
+	role_ids = [keycloak_role.editor.id]
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_group_memberships" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.group.id
+	members  = [keycloak_user.user.username]
+}
+
+resource "keycloak_openid_client" "service_account" {
+	realm_id                 = data.keycloak_realm.realm.id
+	client_id                = "%s-service-account"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+resource "keycloak_openid_client_service_account_role" "read" {
+	realm_id                = data.keycloak_realm.realm.id
+	service_account_user_id = keycloak_openid_client.service_account.service_account_user_id
+	client_id               = keycloak_openid_client.api.id
+	role                    = keycloak_role.read.name
+}
+
+data "keycloak_effective_role_mappings" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+
+	depends_on = [
+		keycloak_group_roles.group,
+		keycloak_group_memberships.group,
+	]
+}
+
+data "keycloak_effective_role_mappings" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.group.id
+
+	depends_on = [
+		keycloak_group_roles.group,
+	]
+}
+
+data "keycloak_effective_role_mappings" "service_account" {
+	realm_id                  = data.keycloak_realm.realm.id
+	service_account_client_id = keycloak_openid_client.service_account.id
+
+	depends_on = [
+		keycloak_openid_client_service_account_role.read,
+	]
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix, prefix, prefix)
+}
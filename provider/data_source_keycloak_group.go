@@ -16,16 +16,21 @@ func dataSourceKeycloakGroup() *schema.Resource {
 				Required: true,
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "path"},
 			},
 			"parent_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"path": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "path"},
+				Description:  "The full path of the group, such as /parent/child. Use this instead of name when multiple groups share the same name.",
 			},
 			"attributes": {
 				Type:     schema.TypeMap,
@@ -39,9 +44,15 @@ func dataSourceKeycloakGroupRead(ctx context.Context, data *schema.ResourceData,
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
-	groupName := data.Get("name").(string)
 
-	group, err := keycloakClient.GetGroupByName(ctx, realmId, groupName)
+	var group *keycloak.Group
+	var err error
+
+	if groupPath, ok := data.GetOk("path"); ok {
+		group, err = keycloakClient.GetGroupByPath(ctx, realmId, groupPath.(string))
+	} else {
+		group, err = keycloakClient.GetGroupByName(ctx, realmId, data.Get("name").(string))
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -65,6 +66,47 @@ func TestAccKeycloakDataSourceGroup_nested(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDataSourceGroup_path(t *testing.T) {
+	t.Parallel()
+
+	firstParent := acctest.RandomWithPrefix("tf-acc")
+	secondParent := acctest.RandomWithPrefix("tf-acc")
+	child := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakGroup_path(firstParent, secondParent, child),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_group.second_child", "id", "data.keycloak_group.group", "id"),
+					resource.TestCheckResourceAttrPair("keycloak_group.second_child", "parent_id", "data.keycloak_group.group", "parent_id"),
+					resource.TestCheckResourceAttr("data.keycloak_group.group", "name", child),
+					resource.TestCheckResourceAttr("data.keycloak_group.group", "path", fmt.Sprintf("/%s/%s", secondParent, child)),
+					testAccCheckDataKeycloakGroup("data.keycloak_group.group"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceGroup_pathNotFound(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakGroup_pathNotFound(acctest.RandomWithPrefix("tf-acc")),
+				ExpectError: regexp.MustCompile("no group with path"),
+			},
+		},
+	})
+}
+
 func testAccCheckDataKeycloakGroup(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -154,3 +196,57 @@ data "keycloak_group" "group_nested" {
 }
 	`, testAccRealm.Realm, group, groupNested)
 }
+
+func testDataSourceKeycloakGroup_path(firstParent, secondParent, child string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "first_parent" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_group" "second_parent" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+# both children share the same name, so they can only be told apart by their path
+resource "keycloak_group" "first_child" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	parent_id = keycloak_group.first_parent.id
+}
+
+resource "keycloak_group" "second_child" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	parent_id = keycloak_group.second_parent.id
+}
+
+data "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	path     = "/${keycloak_group.second_parent.name}/${keycloak_group.second_child.name}"
+
+	depends_on = [
+		keycloak_group.first_child,
+		keycloak_group.second_child,
+	]
+}
+	`, testAccRealm.Realm, firstParent, secondParent, child, child)
+}
+
+func testDataSourceKeycloakGroup_pathNotFound(group string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	path     = "/%s/%s"
+}
+	`, testAccRealm.Realm, group, group)
+}
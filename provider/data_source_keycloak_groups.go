@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"include_member_count": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the number of direct members of each group is fetched as well. This requires an additional request per group.",
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"realm_roles": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"client_roles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"roles": {
+										Type:     schema.TypeSet,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Computed: true,
+									},
+								},
+							},
+						},
+						"member_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakGroupsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	includeMemberCount := data.Get("include_member_count").(bool)
+
+	groups, err := keycloakClient.GetGroupTree(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var groupsData []interface{}
+	for _, group := range groups {
+		attributes := map[string]string{}
+		for k, v := range group.Attributes {
+			attributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		// the client roles are keyed by the client id, sort them to keep the output stable
+		var clientIds []string
+		for clientId := range group.ClientRoles {
+			clientIds = append(clientIds, clientId)
+		}
+		sort.Strings(clientIds)
+
+		var clientRoles []interface{}
+		for _, clientId := range clientIds {
+			clientRoles = append(clientRoles, map[string]interface{}{
+				"client_id": clientId,
+				"roles":     group.ClientRoles[clientId],
+			})
+		}
+
+		groupData := map[string]interface{}{
+			"id":           group.Id,
+			"name":         group.Name,
+			"path":         group.Path,
+			"parent_id":    group.ParentId,
+			"attributes":   attributes,
+			"realm_roles":  group.RealmRoles,
+			"client_roles": clientRoles,
+		}
+
+		if includeMemberCount {
+			members, err := keycloakClient.GetGroupMembers(ctx, realmId, group.Id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			groupData["member_count"] = len(members)
+		}
+
+		groupsData = append(groupsData, groupData)
+	}
+
+	data.SetId(realmId)
+	data.Set("groups", groupsData)
+
+	return nil
+}
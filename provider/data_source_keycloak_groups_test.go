@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceGroups_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	dataSourceName := "data.keycloak_groups.groups"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakGroups_basic(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "groups.*", map[string]string{
+						"name":            "root",
+						"path":            "/root",
+						"parent_id":       "",
+						"member_count":    "0",
+						"attributes.team": "platform",
+						"realm_roles.#":   "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "groups.*", map[string]string{
+						"name":         "child",
+						"path":         "/root/child",
+						"member_count": "0",
+					}),
+					// groups that are nested deeper than one level must be found as well
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "groups.*", map[string]string{
+						"name":                   "grandchild",
+						"path":                   "/root/child/grandchild",
+						"member_count":           "1",
+						"client_roles.#":         "1",
+						"client_roles.0.roles.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "groups.*.parent_id", "keycloak_group.child", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakGroups_basic(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role" {
+	realm_id = keycloak_realm.realm.id
+	name     = "auditor"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = keycloak_realm.realm.id
+	client_id   = "reporting"
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "viewer"
+}
+
+resource "keycloak_group" "root" {
+	realm_id = keycloak_realm.realm.id
+	name     = "root"
+
+	attributes = {
+		team = "platform"
+	}
+}
+
+resource "keycloak_group" "child" {
+	realm_id  = keycloak_realm.realm.id
+	parent_id = keycloak_group.root.id
+	name      = "child"
+}
+
+resource "keycloak_group" "grandchild" {
+	realm_id  = keycloak_realm.realm.id
+	parent_id = keycloak_group.child.id
+	name      = "grandchild"
+}
+
+resource "keycloak_group_roles" "root" {
+	realm_id = keycloak_realm.realm.id
+	group_id = keycloak_group.root.id
+	role_ids = [keycloak_role.realm_role.id]
+}
+
+resource "keycloak_group_roles" "grandchild" {
+	realm_id = keycloak_realm.realm.id
+	group_id = keycloak_group.grandchild.id
+	role_ids = [keycloak_role.client_role.id]
+}
+
+resource "keycloak_user" "user" {
+	realm_id = keycloak_realm.realm.id
+	username = "member"
+}
+
+resource "keycloak_group_memberships" "grandchild" {
+	realm_id = keycloak_realm.realm.id
+	group_id = keycloak_group.grandchild.id
+	members  = [keycloak_user.user.username]
+}
+
+data "keycloak_groups" "groups" {
+	realm_id             = keycloak_realm.realm.id
+	include_member_count = true
+
+	depends_on = [
+		keycloak_group_roles.root,
+		keycloak_group_roles.grandchild,
+		keycloak_group_memberships.grandchild,
+	]
+}
+	`, realm)
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakIdentityProvider() *schema.Resource {
+	dataSourceSchema := dataSourceKeycloakIdentityProvidersElemSchema()
+	dataSourceSchema["realm"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The realm the identity provider belongs to.",
+	}
+	dataSourceSchema["alias"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The alias of the identity provider.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakIdentityProviderRead,
+		Schema:      dataSourceSchema,
+	}
+}
+
+// the identity providers returned by the keycloak_identity_providers data source expose the same attributes as the keycloak_identity_provider data source
+func dataSourceKeycloakIdentityProvidersElemSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"internal_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"alias": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"provider_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"store_token": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"add_read_token_role_on_create": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"authenticate_by_default": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"link_only": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"hide_on_login_page": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"trust_email": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"first_broker_login_flow_alias": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"post_broker_login_flow_alias": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"config": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "The config of the identity provider, keyed by the names Keycloak uses for them, such as clientId or singleSignOnServiceUrl.",
+		},
+	}
+}
+
+func flattenIdentityProvider(identityProvider *keycloak.IdentityProvider) (map[string]interface{}, error) {
+	config := map[string]string{}
+
+	if identityProvider.Config != nil {
+		configJson, err := json.Marshal(identityProvider.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		var configValues map[string]interface{}
+		err = json.Unmarshal(configJson, &configValues)
+		if err != nil {
+			return nil, err
+		}
+
+		// every typed config field is marshalled, including the ones keycloak did not return, so empty values are left out
+		for k, v := range configValues {
+			if value := fmt.Sprint(v); v != nil && value != "" {
+				config[k] = value
+			}
+		}
+	}
+
+	// since keycloak v26 hideOnLogin is not part of the identity provider config anymore
+	hideOnLoginPage := identityProvider.HideOnLogin
+	if identityProvider.Config != nil && bool(identityProvider.Config.HideOnLoginPage) {
+		hideOnLoginPage = true
+	}
+
+	return map[string]interface{}{
+		"internal_id":                   identityProvider.InternalId,
+		"alias":                         identityProvider.Alias,
+		"display_name":                  identityProvider.DisplayName,
+		"provider_id":                   identityProvider.ProviderId,
+		"enabled":                       identityProvider.Enabled,
+		"store_token":                   identityProvider.StoreToken,
+		"add_read_token_role_on_create": identityProvider.AddReadTokenRoleOnCreate,
+		"authenticate_by_default":       identityProvider.AuthenticateByDefault,
+		"link_only":                     identityProvider.LinkOnly,
+		"hide_on_login_page":            hideOnLoginPage,
+		"trust_email":                   identityProvider.TrustEmail,
+		"first_broker_login_flow_alias": identityProvider.FirstBrokerLoginFlowAlias,
+		"post_broker_login_flow_alias":  identityProvider.PostBrokerLoginFlowAlias,
+		"config":                        config,
+	}, nil
+}
+
+func dataSourceKeycloakIdentityProviderRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+	alias := data.Get("alias").(string)
+
+	identityProvider, err := keycloakClient.GetIdentityProvider(ctx, realm, alias)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return diag.Errorf("identity provider with alias %s does not exist in realm %s", alias, realm)
+		}
+
+		return diag.FromErr(err)
+	}
+
+	flattenedIdentityProvider, err := flattenIdentityProvider(identityProvider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(identityProvider.Alias)
+	for k, v := range flattenedIdentityProvider {
+		data.Set(k, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakIdentityProviderMetadata() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakIdentityProviderMetadataRead,
+		Schema: map[string]*schema.Schema{
+			"realm": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The realm used to parse the metadata. No identity provider is created within it.",
+			},
+			"metadata_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"metadata_url", "metadata_xml", "discovery_url"},
+				Description:  "The url of the SAML entity descriptor of the identity provider.",
+			},
+			"metadata_xml": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"metadata_url", "metadata_xml", "discovery_url"},
+				Description:  "The SAML entity descriptor of the identity provider.",
+			},
+			"discovery_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"metadata_url", "metadata_xml", "discovery_url"},
+				Description:  "The url of the OpenID Connect discovery document of the identity provider, usually ending with /.well-known/openid-configuration.",
+			},
+			"provider_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// saml
+			"idp_entity_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"single_sign_on_service_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"single_logout_service_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name_id_policy_format": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"post_binding_authn_request": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"post_binding_response": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"post_binding_logout": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"want_authn_requests_signed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			// oidc
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_info_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jwks_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logout_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"use_jwks_url": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			// shared
+			"validate_signature": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"extra_config": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Every other config value keycloak parsed from the metadata.",
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakIdentityProviderMetadataRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+
+	var providerId, source string
+	var config *keycloak.IdentityProviderConfig
+	var err error
+
+	if discoveryUrl, ok := data.GetOk("discovery_url"); ok {
+		providerId, source = "oidc", discoveryUrl.(string)
+		config, err = keycloakClient.ImportIdentityProviderConfigFromUrl(ctx, realm, providerId, source)
+	} else if metadataUrl, ok := data.GetOk("metadata_url"); ok {
+		providerId, source = "saml", metadataUrl.(string)
+		config, err = keycloakClient.ImportIdentityProviderConfigFromUrl(ctx, realm, providerId, source)
+	} else {
+		providerId, source = "saml", data.Get("metadata_xml").(string)
+		config, err = keycloakClient.ImportIdentityProviderConfigFromFile(ctx, realm, providerId, []byte(source))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	extraConfig := map[string]string{}
+	for k, v := range config.ExtraConfig {
+		if s, ok := v.(string); ok {
+			extraConfig[k] = s
+		}
+	}
+
+	sum := sha1.Sum([]byte(source))
+
+	data.SetId(hex.EncodeToString(sum[:]))
+	data.Set("provider_id", providerId)
+	data.Set("idp_entity_id", extraConfig["idpEntityId"])
+	data.Set("single_sign_on_service_url", config.SingleSignOnServiceUrl)
+	data.Set("single_logout_service_url", config.SingleLogoutServiceUrl)
+	data.Set("signing_certificate", config.SigningCertificate)
+	data.Set("name_id_policy_format", config.NameIDPolicyFormat)
+	data.Set("post_binding_authn_request", config.PostBindingAuthnRequest)
+	data.Set("post_binding_response", config.PostBindingResponse)
+	data.Set("post_binding_logout", config.PostBindingLogout)
+	data.Set("want_authn_requests_signed", config.WantAuthnRequestsSigned)
+	data.Set("issuer", config.Issuer)
+	data.Set("authorization_url", config.AuthorizationUrl)
+	data.Set("token_url", config.TokenUrl)
+	data.Set("user_info_url", config.UserInfoUrl)
+	data.Set("jwks_url", config.JwksUrl)
+	data.Set("logout_url", config.LogoutUrl)
+	data.Set("use_jwks_url", config.UseJwksUrl)
+	data.Set("validate_signature", config.ValidateSignature)
+	data.Set("extra_config", extraConfig)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceIdentityProviderMetadata_discoveryUrl(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.keycloak_identity_provider_metadata.metadata"
+	realmUrl := fmt.Sprintf("%s/realms/%s", os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakIdentityProviderMetadata_discoveryUrl(realmUrl + "/.well-known/openid-configuration"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "provider_id", "oidc"),
+					resource.TestCheckResourceAttr(dataSourceName, "token_url", realmUrl+"/protocol/openid-connect/token"),
+					resource.TestCheckResourceAttr(dataSourceName, "authorization_url", realmUrl+"/protocol/openid-connect/auth"),
+					resource.TestCheckResourceAttr(dataSourceName, "jwks_url", realmUrl+"/protocol/openid-connect/certs"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "issuer"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceIdentityProviderMetadata_metadataUrl(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.keycloak_identity_provider_metadata.metadata"
+	realmUrl := fmt.Sprintf("%s/realms/%s", os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakIdentityProviderMetadata_metadataUrl(realmUrl + "/protocol/saml/descriptor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "provider_id", "saml"),
+					resource.TestCheckResourceAttr(dataSourceName, "single_sign_on_service_url", realmUrl+"/protocol/saml"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "signing_certificate"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceIdentityProviderMetadata_metadataXml(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.keycloak_identity_provider_metadata.metadata"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakIdentityProviderMetadata_metadataXml(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "provider_id", "saml"),
+					resource.TestCheckResourceAttr(dataSourceName, "idp_entity_id", "FakeIdpEntityId"),
+					resource.TestCheckResourceAttr(dataSourceName, "single_sign_on_service_url", "https://idp.example.com/saml/sso"),
+					resource.TestCheckResourceAttr(dataSourceName, "single_logout_service_url", "https://idp.example.com/saml/slo"),
+					resource.TestCheckResourceAttr(dataSourceName, "name_id_policy_format", "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"),
+					resource.TestCheckResourceAttr(dataSourceName, "post_binding_authn_request", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "want_authn_requests_signed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "signing_certificate", "MIICyDCCAjGgAwIBAgIBADANBgkqhkiG9w0BAQ0FADCBgDELMAkGA1UEBhMCdXMxCzAJBgNVBAgMAklBMSQwIgYDVQQKDBt0ZXJyYWZvcm0tcHJvdmlkZXIta2V5Y2xvYWsxHDAaBgNVBAMME21ycGFya2Vycy5naXRodWIuaW8xIDAeBgkqhkiG9w0BCQEWEW1pY2hhZWxAcGFya2VyLmdnMB4XDTE5MDEwODE0NDYzNloXDTI5MDEwNTE0NDYzNlowgYAxCzAJBgNVBAYTAnVzMQswCQYDVQQIDAJJQTEkMCIGA1UECgwbdGVycmFmb3JtLXByb3ZpZGVyLWtleWNsb2FrMRwwGgYDVQQDDBNtcnBhcmtlcnMuZ2l0aHViLmlvMSAwHgYJKoZIhvcNAQkBFhFtaWNoYWVsQHBhcmtlci5nZzCBnzANBgkqhkiG9w0BAQEFAAOBjQAwgYkCgYEAxuZny7uyYxGVPtpie14gNQC4tT9sAvO2sVNDhuoeqIKLRpNwkHnwQmwe5OxSh9K0BPHp/DNuuVWUqvo4tniEYn3jBr7FwLYLTKojQIxj53S1UTT9EXq3eP5HsHMD0QnTuca2nlNYUDBm6ud2fQj0Jt5qLx86EbEC28N56IRvGX8CAwEAAaNQME4wHQYDVR0OBBYEFMLnbQh77j7vhGTpAhKpDhCrBsPZMB8GA1UdIwQYMBaAFMLnbQh77j7vhGTpAhKpDhCrBsPZMAwGA1UdEwQFMAMBAf8wDQYJKoZIhvcNAQENBQADgYEAB8wGrAQY0pAfwbnYSyBt4STbebeRTu1/q1ucfrtc3qsegcd5n01xTR+T2uZJwqHFPpFjr4IPORiHx3+4BWCweslPD53qBjKUPXcbMO1Revjef6TjK3K0AuJ94fxgXVoT61Nzu/a6Lj6RhzU/Dao9mlSbJY+YSbm+ZBpsuRUQ84s="),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakIdentityProviderMetadata_discoveryUrl(discoveryUrl string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_identity_provider_metadata" "metadata" {
+	realm         = data.keycloak_realm.realm.id
+	discovery_url = "%s"
+}
+	`, testAccRealm.Realm, discoveryUrl)
+}
+
+func testAccKeycloakIdentityProviderMetadata_metadataUrl(metadataUrl string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_identity_provider_metadata" "metadata" {
+	realm        = data.keycloak_realm.realm.id
+	metadata_url = "%s"
+}
+	`, testAccRealm.Realm, metadataUrl)
+}
+
+func testAccKeycloakIdentityProviderMetadata_metadataXml() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_identity_provider_metadata" "metadata" {
+	realm        = data.keycloak_realm.realm.id
+	metadata_xml = <<EOF
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="FakeIdpEntityId">
+	<md:IDPSSODescriptor WantAuthnRequestsSigned="true" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+		<md:KeyDescriptor use="signing">
+			<ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+				<ds:X509Data>
+					<ds:X509Certificate>MIICyDCCAjGgAwIBAgIBADANBgkqhkiG9w0BAQ0FADCBgDELMAkGA1UEBhMCdXMxCzAJBgNVBAgMAklBMSQwIgYDVQQKDBt0ZXJyYWZvcm0tcHJvdmlkZXIta2V5Y2xvYWsxHDAaBgNVBAMME21ycGFya2Vycy5naXRodWIuaW8xIDAeBgkqhkiG9w0BCQEWEW1pY2hhZWxAcGFya2VyLmdnMB4XDTE5MDEwODE0NDYzNloXDTI5MDEwNTE0NDYzNlowgYAxCzAJBgNVBAYTAnVzMQswCQYDVQQIDAJJQTEkMCIGA1UECgwbdGVycmFmb3JtLXByb3ZpZGVyLWtleWNsb2FrMRwwGgYDVQQDDBNtcnBhcmtlcnMuZ2l0aHViLmlvMSAwHgYJKoZIhvcNAQkBFhFtaWNoYWVsQHBhcmtlci5nZzCBnzANBgkqhkiG9w0BAQEFAAOBjQAwgYkCgYEAxuZny7uyYxGVPtpie14gNQC4tT9sAvO2sVNDhuoeqIKLRpNwkHnwQmwe5OxSh9K0BPHp/DNuuVWUqvo4tniEYn3jBr7FwLYLTKojQIxj53S1UTT9EXq3eP5HsHMD0QnTuca2nlNYUDBm6ud2fQj0Jt5qLx86EbEC28N56IRvGX8CAwEAAaNQME4wHQYDVR0OBBYEFMLnbQh77j7vhGTpAhKpDhCrBsPZMB8GA1UdIwQYMBaAFMLnbQh77j7vhGTpAhKpDhCrBsPZMAwGA1UdEwQFMAMBAf8wDQYJKoZIhvcNAQENBQADgYEAB8wGrAQY0pAfwbnYSyBt4STbebeRTu1/q1ucfrtc3qsegcd5n01xTR+T2uZJwqHFPpFjr4IPORiHx3+4BWCweslPD53qBjKUPXcbMO1Revjef6TjK3K0AuJ94fxgXVoT61Nzu/a6Lj6RhzU/Dao9mlSbJY+YSbm+ZBpsuRUQ84s=</ds:X509Certificate>
+				</ds:X509Data>
+			</ds:KeyInfo>
+		</md:KeyDescriptor>
+		<md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/saml/slo"/>
+		<md:NameIDFormat>urn:oasis:names:tc:SAML:2.0:nameid-format:persistent</md:NameIDFormat>
+		<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/saml/sso"/>
+	</md:IDPSSODescriptor>
+</md:EntityDescriptor>
+	EOF
+}
+`, testAccRealm.Realm)
+}
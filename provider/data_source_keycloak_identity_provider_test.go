@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_identity_provider.oidc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakIdentityProvider_basic(alias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "internal_id", "keycloak_oidc_identity_provider.oidc", "internal_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "alias", alias),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", "Example"),
+					resource.TestCheckResourceAttr(dataSourceName, "provider_id", "oidc"),
+					resource.TestCheckResourceAttr(dataSourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "trust_email", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "first_broker_login_flow_alias", "first broker login"),
+					resource.TestCheckResourceAttr(dataSourceName, "config.clientId", "example_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "config.tokenUrl", "https://example.com/token"),
+					resource.TestCheckResourceAttr(dataSourceName, "config.team", "platform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceIdentityProvider_notFound(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakIdentityProvider_notFound(alias),
+				ExpectError: regexp.MustCompile("identity provider with alias .+ does not exist"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakIdentityProvider_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	display_name      = "Example"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+	trust_email       = true
+
+	extra_config = {
+		team = "platform"
+	}
+}
+
+data "keycloak_identity_provider" "oidc" {
+	realm = data.keycloak_realm.realm.id
+	alias = keycloak_oidc_identity_provider.oidc.alias
+}
+	`, testAccRealm.Realm, alias)
+}
+
+func testDataSourceKeycloakIdentityProvider_notFound(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_identity_provider" "oidc" {
+	realm = data.keycloak_realm.realm.id
+	alias = "%s"
+}
+	`, testAccRealm.Realm, alias)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakIdentityProviders() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakIdentityProvidersRead,
+		Schema: map[string]*schema.Schema{
+			"realm": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The realm the identity providers belong to.",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "When set, only identity providers with this provider id are returned, such as oidc, saml or github.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "When set, only identity providers that are enabled or disabled are returned.",
+			},
+			"identity_providers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceKeycloakIdentityProvidersElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakIdentityProvidersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+
+	identityProviders, err := keycloakClient.GetIdentityProviders(ctx, realm)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedIdentityProviders := make([]interface{}, 0)
+	for _, identityProvider := range identityProviders {
+		if providerId, ok := data.GetOk("provider_id"); ok && providerId.(string) != identityProvider.ProviderId {
+			continue
+		}
+
+		if enabled, ok := data.GetOkExists("enabled"); ok && enabled.(bool) != identityProvider.Enabled {
+			continue
+		}
+
+		flattenedIdentityProvider, err := flattenIdentityProvider(identityProvider)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		flattenedIdentityProviders = append(flattenedIdentityProviders, flattenedIdentityProvider)
+	}
+
+	data.SetId(realm)
+
+	err = data.Set("identity_providers", flattenedIdentityProviders)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
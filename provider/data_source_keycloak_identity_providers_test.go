@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceIdentityProviders_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakIdentityProviders_basic(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_identity_providers.all", "identity_providers.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_identity_providers.all", "identity_providers.*", map[string]string{
+						"alias":                         "saml",
+						"provider_id":                   "saml",
+						"config.singleSignOnServiceUrl": "https://example.com/saml",
+						"first_broker_login_flow_alias": "first broker login",
+						"enabled":                       "true",
+					}),
+					resource.TestCheckResourceAttr("data.keycloak_identity_providers.oidc", "identity_providers.#", "2"),
+					resource.TestCheckResourceAttr("data.keycloak_identity_providers.enabled_oidc", "identity_providers.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_identity_providers.enabled_oidc", "identity_providers.0.alias", "oidc-enabled"),
+					resource.TestCheckResourceAttr("data.keycloak_identity_providers.disabled", "identity_providers.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_identity_providers.disabled", "identity_providers.0.alias", "oidc-disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakIdentityProviders_basic(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "enabled" {
+	realm             = keycloak_realm.realm.id
+	alias             = "oidc-enabled"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_oidc_identity_provider" "disabled" {
+	realm             = keycloak_realm.realm.id
+	alias             = "oidc-disabled"
+	enabled           = false
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = keycloak_realm.realm.id
+	alias                      = "saml"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/saml"
+}
+
+data "keycloak_identity_providers" "all" {
+	realm = keycloak_realm.realm.id
+
+	depends_on = [
+		keycloak_oidc_identity_provider.enabled,
+		keycloak_oidc_identity_provider.disabled,
+		keycloak_saml_identity_provider.saml,
+	]
+}
+
+data "keycloak_identity_providers" "oidc" {
+	realm       = keycloak_realm.realm.id
+	provider_id = "oidc"
+
+	depends_on = [
+		keycloak_oidc_identity_provider.enabled,
+		keycloak_oidc_identity_provider.disabled,
+		keycloak_saml_identity_provider.saml,
+	]
+}
+
+data "keycloak_identity_providers" "enabled_oidc" {
+	realm       = keycloak_realm.realm.id
+	provider_id = "oidc"
+	enabled     = true
+
+	depends_on = [
+		keycloak_oidc_identity_provider.enabled,
+		keycloak_oidc_identity_provider.disabled,
+		keycloak_saml_identity_provider.saml,
+	]
+}
+
+data "keycloak_identity_providers" "disabled" {
+	realm   = keycloak_realm.realm.id
+	enabled = false
+
+	depends_on = [
+		keycloak_oidc_identity_provider.enabled,
+		keycloak_oidc_identity_provider.disabled,
+		keycloak_saml_identity_provider.saml,
+	]
+}
+	`, realm)
+}
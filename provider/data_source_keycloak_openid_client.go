@@ -238,6 +238,23 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"jwt_credential_issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jwt_credential_subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"oauth2_jwt_authorization_grant_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"oauth2_jwt_authorization_grant_identity_providers": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
 			"always_display_in_console": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakDataSourceOpenidClientInstallationProvider_basic(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_client.client"
+	dataSourceName := "data.keycloak_openid_client_installation_provider.keycloak_json"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakOpenidClientInstallationProvider_basic(clientId, "keycloak-oidc-keycloak-json"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "realm_id", resourceName, "realm_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "provider_id", "keycloak-oidc-keycloak-json"),
+					testAccCheckDataKeycloakOpenidClientInstallationProvider(dataSourceName, clientId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceOpenidClientInstallationProvider_invalidProvider(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakOpenidClientInstallationProvider_basic(clientId, "does-not-exist"),
+				ExpectError: regexp.MustCompile("validation error: client installation provider \"does-not-exist\" does not exist on the server"),
+			},
+		},
+	})
+}
+
+func testAccCheckDataKeycloakOpenidClientInstallationProvider(resourceName, clientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		value := rs.Primary.Attributes["value"]
+
+		var keycloakJson map[string]interface{}
+		err := json.Unmarshal([]byte(value), &keycloakJson)
+		if err != nil {
+			return fmt.Errorf("invalid JSON: %s\n%s", err, value)
+		}
+
+		if keycloakJson["resource"] != clientId {
+			return fmt.Errorf("expected resource to be %s, got %v", clientId, keycloakJson["resource"])
+		}
+
+		return nil
+	}
+}
+
+func testDataSourceKeycloakOpenidClientInstallationProvider_basic(clientId, providerId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+}
+
+data "keycloak_openid_client_installation_provider" "keycloak_json" {
+  realm_id    = data.keycloak_realm.realm.id
+  client_id   = keycloak_openid_client.client.id
+  provider_id = "%s"
+}
+	`, testAccRealm.Realm, clientId, providerId)
+}
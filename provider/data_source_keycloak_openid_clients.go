@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakOpenidClients() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakOpenidClientsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"access_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientAccessTypes, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"service_accounts_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"attribute": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"include_secrets": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"clients": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceKeycloakOpenidClientsElemSchema(),
+				},
+			},
+		},
+	}
+}
+
+// the clients returned by this data source expose the same attributes as the keycloak_openid_client data source
+func dataSourceKeycloakOpenidClientsElemSchema() map[string]*schema.Schema {
+	elemSchema := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	for key, value := range dataSourceKeycloakOpenidClient().Schema {
+		computedSchema := *value
+		computedSchema.Required = false
+		computedSchema.Optional = false
+		computedSchema.Default = nil
+		computedSchema.Computed = true
+
+		elemSchema[key] = &computedSchema
+	}
+
+	// only set when include_secrets is true, but never shown in plan output
+	elemSchema["client_secret"].Sensitive = true
+
+	return elemSchema
+}
+
+func openidClientMatchesFilters(data *schema.ResourceData, client *keycloak.OpenidClient, clientIdRegex *regexp.Regexp) (bool, error) {
+	// the clients endpoint also returns saml clients. some built-in clients do not have a protocol set
+	if client.Protocol != "" && client.Protocol != "openid-connect" {
+		return false, nil
+	}
+
+	if clientIdRegex != nil && !clientIdRegex.MatchString(client.ClientId) {
+		return false, nil
+	}
+
+	if accessType, ok := data.GetOk("access_type"); ok && accessType.(string) != getOpenidClientAccessType(client) {
+		return false, nil
+	}
+
+	if enabled, ok := data.GetOkExists("enabled"); ok && enabled.(bool) != client.Enabled {
+		return false, nil
+	}
+
+	if serviceAccountsEnabled, ok := data.GetOkExists("service_accounts_enabled"); ok && serviceAccountsEnabled.(bool) != client.ServiceAccountsEnabled {
+		return false, nil
+	}
+
+	if v, ok := data.GetOk("attribute"); ok {
+		attributeFilter := v.([]interface{})[0].(map[string]interface{})
+
+		attributesJson, err := client.Attributes.MarshalJSON()
+		if err != nil {
+			return false, err
+		}
+
+		var attributes map[string]interface{}
+		err = json.Unmarshal(attributesJson, &attributes)
+		if err != nil {
+			return false, err
+		}
+
+		attributeValue, ok := attributes[attributeFilter["key"].(string)]
+		if !ok {
+			return false, nil
+		}
+
+		if expectedValue := attributeFilter["value"].(string); expectedValue != "" && fmt.Sprint(attributeValue) != expectedValue {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func flattenOpenidClient(ctx context.Context, keycloakClient *keycloak.KeycloakClient, client *keycloak.OpenidClient) (map[string]interface{}, error) {
+	var serviceAccountUserId string
+	if client.ServiceAccountsEnabled {
+		serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(ctx, client.RealmId, client.Id)
+		if err != nil {
+			return nil, err
+		}
+		serviceAccountUserId = serviceAccountUser.Id
+	}
+
+	flattenedClient := map[string]interface{}{
+		"id":                                                client.Id,
+		"client_id":                                         client.ClientId,
+		"realm_id":                                          client.RealmId,
+		"name":                                              client.Name,
+		"enabled":                                           client.Enabled,
+		"description":                                       client.Description,
+		"access_type":                                       getOpenidClientAccessType(client),
+		"client_secret":                                     client.ClientSecret,
+		"client_authenticator_type":                         client.ClientAuthenticatorType,
+		"standard_flow_enabled":                             client.StandardFlowEnabled,
+		"implicit_flow_enabled":                             client.ImplicitFlowEnabled,
+		"direct_access_grants_enabled":                      client.DirectAccessGrantsEnabled,
+		"service_accounts_enabled":                          client.ServiceAccountsEnabled,
+		"frontchannel_logout_enabled":                       client.FrontChannelLogoutEnabled,
+		"valid_redirect_uris":                               client.ValidRedirectUris,
+		"valid_post_logout_redirect_uris":                   []string(client.Attributes.PostLogoutRedirectUris),
+		"web_origins":                                       client.WebOrigins,
+		"root_url":                                          StringValue(client.RootUrl),
+		"admin_url":                                         client.AdminUrl,
+		"base_url":                                          client.BaseUrl,
+		"service_account_user_id":                           serviceAccountUserId,
+		"pkce_code_challenge_method":                        client.Attributes.PkceCodeChallengeMethod,
+		"access_token_lifespan":                             client.Attributes.AccessTokenLifespan,
+		"client_offline_session_idle_timeout":               client.Attributes.ClientOfflineSessionIdleTimeout,
+		"client_offline_session_max_lifespan":               client.Attributes.ClientOfflineSessionMaxLifespan,
+		"client_session_idle_timeout":                       client.Attributes.ClientSessionIdleTimeout,
+		"client_session_max_lifespan":                       client.Attributes.ClientSessionMaxLifespan,
+		"exclude_session_state_from_auth_response":          bool(client.Attributes.ExcludeSessionStateFromAuthResponse),
+		"exclude_issuer_from_auth_response":                 bool(client.Attributes.ExcludeIssuerFromAuthResponse),
+		"full_scope_allowed":                                client.FullScopeAllowed,
+		"consent_required":                                  client.ConsentRequired,
+		"display_on_consent_screen":                         bool(client.Attributes.DisplayOnConsentScreen),
+		"consent_screen_text":                               client.Attributes.ConsentScreenText,
+		"login_theme":                                       client.Attributes.LoginTheme,
+		"use_refresh_tokens":                                bool(client.Attributes.UseRefreshTokens),
+		"use_refresh_tokens_client_credentials":             bool(client.Attributes.UseRefreshTokensClientCredentials),
+		"backchannel_logout_url":                            client.Attributes.BackchannelLogoutUrl,
+		"frontchannel_logout_url":                           client.Attributes.FrontchannelLogoutUrl,
+		"backchannel_logout_session_required":               bool(client.Attributes.BackchannelLogoutSessionRequired),
+		"backchannel_logout_revoke_offline_sessions":        bool(client.Attributes.BackchannelLogoutRevokeOfflineTokens),
+		"extra_config":                                      client.Attributes.ExtraConfig,
+		"oauth2_device_authorization_grant_enabled":         bool(client.Attributes.Oauth2DeviceAuthorizationGrantEnabled),
+		"oauth2_device_code_lifespan":                       client.Attributes.Oauth2DeviceCodeLifespan,
+		"oauth2_device_polling_interval":                    client.Attributes.Oauth2DevicePollingInterval,
+		"jwt_credential_issuer":                             client.Attributes.JwtCredentialIssuer,
+		"jwt_credential_subject":                            client.Attributes.JwtCredentialSubject,
+		"oauth2_jwt_authorization_grant_enabled":            bool(client.Attributes.Oauth2JwtAuthorizationGrantEnabled),
+		"oauth2_jwt_authorization_grant_identity_providers": []string(client.Attributes.Oauth2JwtAuthorizationGrantIdps),
+		"always_display_in_console":                         client.AlwaysDisplayInConsole,
+	}
+
+	if client.AuthorizationServicesEnabled {
+		flattenedClient["resource_server_id"] = client.Id
+	}
+
+	if client.AuthorizationSettings != nil {
+		flattenedClient["authorization"] = []interface{}{
+			map[string]interface{}{
+				"policy_enforcement_mode":          client.AuthorizationSettings.PolicyEnforcementMode,
+				"decision_strategy":                client.AuthorizationSettings.DecisionStrategy,
+				"allow_remote_resource_management": client.AuthorizationSettings.AllowRemoteResourceManagement,
+				"keep_defaults":                    client.AuthorizationSettings.KeepDefaults,
+			},
+		}
+	}
+
+	if (keycloak.OpenidAuthenticationFlowBindingOverrides{}) != client.AuthenticationFlowBindingOverrides {
+		flattenedClient["authentication_flow_binding_overrides"] = []interface{}{
+			map[string]interface{}{
+				"browser_id":      client.AuthenticationFlowBindingOverrides.BrowserId,
+				"direct_grant_id": client.AuthenticationFlowBindingOverrides.DirectGrantId,
+			},
+		}
+	}
+
+	return flattenedClient, nil
+}
+
+func dataSourceKeycloakOpenidClientsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	includeSecrets := data.Get("include_secrets").(bool)
+
+	var clientIdRegex *regexp.Regexp
+	if v, ok := data.GetOk("client_id_regex"); ok {
+		clientIdRegex = regexp.MustCompile(v.(string))
+	}
+
+	clients, err := keycloakClient.GetOpenidClients(ctx, realmId, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedClients := make([]interface{}, 0)
+	for _, client := range clients {
+		matches, err := openidClientMatchesFilters(data, client, clientIdRegex)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !matches {
+			continue
+		}
+
+		// the clients endpoint returns the secret of confidential clients as well, so it has to be removed explicitly
+		client.ClientSecret = ""
+
+		// secrets are only fetched for the clients that matched, since this takes an additional request per client
+		if includeSecrets && getOpenidClientAccessType(client) == "CONFIDENTIAL" {
+			clientSecret, err := keycloakClient.GetOpenidClientSecret(ctx, realmId, client.Id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			client.ClientSecret = clientSecret.Value
+		}
+
+		flattenedClient, err := flattenOpenidClient(ctx, keycloakClient, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		flattenedClients = append(flattenedClients, flattenedClient)
+	}
+
+	data.SetId(realmId)
+
+	err = data.Set("clients", flattenedClients)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
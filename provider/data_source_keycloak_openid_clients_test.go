@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceOpenidClients_basic(t *testing.T) {
+	t.Parallel()
+	clientPrefix := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.keycloak_openid_clients.confidential"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakOpenidClientsConfig(clientPrefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "clients.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "clients.0.id", "keycloak_openid_client.confidential", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "clients.0.client_id", "keycloak_openid_client.confidential", "client_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "clients.0.service_account_user_id", "keycloak_openid_client.confidential", "service_account_user_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "clients.0.access_type", "CONFIDENTIAL"),
+					resource.TestCheckResourceAttr(dataSourceName, "clients.0.client_secret", ""),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.all", "clients.#", "2"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.public", "clients.#", "1"),
+					resource.TestCheckResourceAttrPair("data.keycloak_openid_clients.public", "clients.0.id", "keycloak_openid_client.public", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.attribute", "clients.#", "1"),
+					resource.TestCheckResourceAttrPair("data.keycloak_openid_clients.attribute", "clients.0.id", "keycloak_openid_client.public", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.secrets", "clients.0.client_secret", "secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenidClientsConfig(clientPrefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "confidential" {
+	client_id                = "%s-confidential"
+	realm_id                 = data.keycloak_realm.realm.id
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+	client_secret            = "secret"
+}
+
+resource "keycloak_openid_client" "public" {
+	client_id             = "%s-public"
+	realm_id              = data.keycloak_realm.realm.id
+	access_type           = "PUBLIC"
+	standard_flow_enabled = true
+	valid_redirect_uris   = [
+		"http://localhost:5555/callback",
+	]
+	extra_config = {
+		"team" = "audit"
+	}
+}
+
+data "keycloak_openid_clients" "all" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id_regex = "^%s-"
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+		keycloak_openid_client.public,
+	]
+}
+
+data "keycloak_openid_clients" "confidential" {
+	realm_id                 = data.keycloak_realm.realm.id
+	client_id_regex          = "^%s-"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+		keycloak_openid_client.public,
+	]
+}
+
+data "keycloak_openid_clients" "public" {
+	realm_id                 = data.keycloak_realm.realm.id
+	client_id_regex          = "^%s-"
+	service_accounts_enabled = false
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+		keycloak_openid_client.public,
+	]
+}
+
+data "keycloak_openid_clients" "attribute" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id_regex = "^%s-"
+
+	attribute {
+		key   = "team"
+		value = "audit"
+	}
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+		keycloak_openid_client.public,
+	]
+}
+
+data "keycloak_openid_clients" "secrets" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id_regex = "^%s-confidential$"
+	include_secrets = true
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+	]
+}
+`, testAccRealm.Realm, clientPrefix, clientPrefix, clientPrefix, clientPrefix, clientPrefix, clientPrefix, clientPrefix)
+}
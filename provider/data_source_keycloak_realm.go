@@ -120,6 +120,10 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"admin_permissions_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 
 			// Login Config
 
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRoleMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRoleMembersRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"include_composites": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, the users and groups that hold the role through a composite role are returned as well. This requires fetching the composites of every composite role within the realm.",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direct": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direct": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakRoleMembersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	roleName := data.Get("name").(string)
+
+	role, err := keycloakClient.GetRoleByName(ctx, realmId, clientId, roleName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roles := []*keycloak.Role{role}
+	if data.Get("include_composites").(bool) {
+		parents, err := keycloakClient.GetRoleParents(ctx, role)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		roles = append(roles, parents...)
+	}
+
+	// a user or group can hold the role in several ways, it is only listed once and marked as direct when the role itself is assigned
+	usersData := make([]interface{}, 0)
+	userIndexes := map[string]int{}
+	groupsData := make([]interface{}, 0)
+	groupIndexes := map[string]int{}
+
+	for _, r := range roles {
+		direct := r.Id == role.Id
+
+		users, err := keycloakClient.GetRoleUsers(ctx, r)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, user := range users {
+			if i, ok := userIndexes[user.Id]; ok {
+				if direct {
+					usersData[i].(map[string]interface{})["direct"] = true
+				}
+				continue
+			}
+
+			userIndexes[user.Id] = len(usersData)
+			usersData = append(usersData, map[string]interface{}{
+				"id":       user.Id,
+				"username": user.Username,
+				"direct":   direct,
+			})
+		}
+
+		groups, err := keycloakClient.GetRoleGroups(ctx, r)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, group := range groups {
+			if i, ok := groupIndexes[group.Id]; ok {
+				if direct {
+					groupsData[i].(map[string]interface{})["direct"] = true
+				}
+				continue
+			}
+
+			groupIndexes[group.Id] = len(groupsData)
+			groupsData = append(groupsData, map[string]interface{}{
+				"id":     group.Id,
+				"name":   group.Name,
+				"path":   group.Path,
+				"direct": direct,
+			})
+		}
+	}
+
+	data.SetId(role.Id)
+	data.Set("users", usersData)
+	data.Set("groups", groupsData)
+
+	return nil
+}
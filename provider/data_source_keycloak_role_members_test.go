@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRoleMembers_basic(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakRoleMembers_basic(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_role_members.all", "users.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_role_members.all", "users.*", map[string]string{
+						"username": prefix + "-direct",
+						"direct":   "true",
+					}),
+					// the parent role is a composite of the role, so its members hold the role as well
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_role_members.all", "users.*", map[string]string{
+						"username": prefix + "-composite",
+						"direct":   "false",
+					}),
+					resource.TestCheckResourceAttr("data.keycloak_role_members.all", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_role_members.all", "groups.0.name", prefix),
+					resource.TestCheckResourceAttr("data.keycloak_role_members.all", "groups.0.path", "/"+prefix),
+					resource.TestCheckResourceAttr("data.keycloak_role_members.all", "groups.0.direct", "false"),
+					resource.TestCheckResourceAttr("data.keycloak_role_members.direct", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_role_members.direct", "users.0.username", prefix+"-direct"),
+					resource.TestCheckResourceAttr("data.keycloak_role_members.direct", "groups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakRoleMembers_basic(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-role"
+}
+
+resource "keycloak_role" "parent" {
+	realm_id        = data.keycloak_realm.realm.id
+	name            = "%s-parent"
+	composite_roles = [keycloak_role.role.id]
+}
+
+resource "keycloak_user" "direct" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-direct"
+}
+
+resource "keycloak_user" "composite" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-composite"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_user_roles" "direct" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.direct.id
+	role_ids = [keycloak_role.role.id]
+}
+
+resource "keycloak_user_roles" "composite" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.composite.id
+	role_ids = [keycloak_role.parent.id]
+}
+
+resource "keycloak_group_roles" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.group.id
+	role_ids = [keycloak_role.parent.id]
+}
+
+data "keycloak_role_members" "all" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = keycloak_role.role.name
+
+	depends_on = [
+		keycloak_user_roles.direct,
+		keycloak_user_roles.composite,
+		keycloak_group_roles.group,
+	]
+}
+
+data "keycloak_role_members" "direct" {
+	realm_id           = data.keycloak_realm.realm.id
+	name               = keycloak_role.role.name
+	include_composites = false
+
+	depends_on = [
+		keycloak_user_roles.direct,
+		keycloak_user_roles.composite,
+		keycloak_group_roles.group,
+	]
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix, prefix, prefix)
+}
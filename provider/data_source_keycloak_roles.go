@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRolesRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "When set, the roles of this client are returned instead of the realm roles.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Only roles that have all of these attributes are returned. A role matches an attribute when one of its values is equal to the given value.",
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"composite": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func roleMatchesAttributes(role *keycloak.Role, attributes map[string]interface{}) bool {
+	for key, expectedValue := range attributes {
+		if !stringSliceContains(role.Attributes[key], expectedValue.(string)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dataSourceKeycloakRolesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	attributes := data.Get("attributes").(map[string]interface{})
+
+	var nameRegex *regexp.Regexp
+	if v, ok := data.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	roles, err := keycloakClient.GetRoles(ctx, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rolesData := make([]interface{}, 0)
+	for _, role := range roles {
+		if nameRegex != nil && !nameRegex.MatchString(role.Name) {
+			continue
+		}
+
+		if !roleMatchesAttributes(role, attributes) {
+			continue
+		}
+
+		roleAttributes := map[string]string{}
+		for k, v := range role.Attributes {
+			roleAttributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		rolesData = append(rolesData, map[string]interface{}{
+			"id":          role.Id,
+			"name":        role.Name,
+			"description": role.Description,
+			"client_id":   role.ClientId,
+			"composite":   role.Composite,
+			"attributes":  roleAttributes,
+		})
+	}
+
+	if clientId == "" {
+		data.SetId(realmId)
+	} else {
+		data.SetId(realmId + "/" + clientId)
+	}
+
+	err = data.Set("roles", rolesData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
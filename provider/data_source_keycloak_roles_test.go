@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRoles_basic(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakRoles_basic(prefix, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_roles.realm", "roles.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_roles.realm", "roles.*", map[string]string{
+						"name":            prefix + "-admin",
+						"description":     "admin",
+						"composite":       "true",
+						"attributes.team": "platform",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_roles.realm", "roles.*", map[string]string{
+						"name":      prefix + "-viewer",
+						"composite": "false",
+					}),
+					resource.TestCheckResourceAttr("data.keycloak_roles.attributes", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_roles.attributes", "roles.0.name", prefix+"-admin"),
+					resource.TestCheckResourceAttr("data.keycloak_roles.client", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_roles.client", "roles.0.name", "reader"),
+					resource.TestCheckResourceAttrPair("data.keycloak_roles.client", "roles.0.client_id", "keycloak_openid_client.client", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakRoles_basic(prefix, clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_role" "viewer" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-viewer"
+
+	attributes = {
+		team = "support"
+	}
+}
+
+resource "keycloak_role" "admin" {
+	realm_id    = data.keycloak_realm.realm.id
+	name        = "%s-admin"
+	description = "admin"
+
+	composite_roles = [keycloak_role.viewer.id]
+
+	attributes = {
+		team = "platform"
+	}
+}
+
+resource "keycloak_role" "reader" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "reader"
+}
+
+data "keycloak_roles" "realm" {
+	realm_id   = data.keycloak_realm.realm.id
+	name_regex = "^%s-"
+
+	depends_on = [
+		keycloak_role.admin,
+		keycloak_role.viewer,
+	]
+}
+
+data "keycloak_roles" "attributes" {
+	realm_id   = data.keycloak_realm.realm.id
+	name_regex = "^%s-"
+
+	attributes = {
+		team = "platform"
+	}
+
+	depends_on = [
+		keycloak_role.admin,
+		keycloak_role.viewer,
+	]
+}
+
+data "keycloak_roles" "client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+
+	depends_on = [
+		keycloak_role.reader,
+	]
+}
+	`, testAccRealm.Realm, clientId, prefix, prefix, prefix, prefix)
+}
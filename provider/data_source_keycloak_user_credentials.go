@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserCredentials() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUserCredentialsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"types": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"credentials": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_date": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUserCredentialsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	types := make(map[string]bool)
+	var credentialsData []interface{}
+	for _, credential := range credentials {
+		types[credential.Type] = true
+		credentialsData = append(credentialsData, map[string]interface{}{
+			"id":           credential.Id,
+			"type":         credential.Type,
+			"user_label":   credential.UserLabel,
+			"created_date": credential.CreatedDate,
+		})
+	}
+
+	var credentialTypes []string
+	for t := range types {
+		credentialTypes = append(credentialTypes, t)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, userId))
+	data.Set("types", credentialTypes)
+	data.Set("credentials", credentialsData)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceUserCredentials_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	dataSourceName := "data.keycloak_user_credentials.credentials"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUserCredentials_basic(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "credentials.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "types.*", "password"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "types.*", "otp"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "credentials.*", map[string]string{
+						"type":       "otp",
+						"user_label": "my phone",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUserCredentials_basic(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	initial_password {
+		value = "initial"
+	}
+}
+
+resource "keycloak_user_credential" "otp" {
+	realm_id   = data.keycloak_realm.realm.id
+	user_id    = keycloak_user.user.id
+	user_label = "my phone"
+
+	otp {
+		secret = "%s"
+	}
+}
+
+data "keycloak_user_credentials" "credentials" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+
+	depends_on = [
+		keycloak_user_credential.otp,
+	]
+}
+	`, testAccRealm.Realm, username, acctest.RandString(20))
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserSessions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUserSessionsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"user_id", "client_id"},
+				Description:  "The user to fetch the sessions of. Use the id of the service account user to fetch the sessions of a service account.",
+			},
+			"client_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"user_id", "client_id"},
+				Description:  "The client to fetch the sessions of. When user_id is set as well, only the sessions of that user for this client are returned.",
+			},
+			"offline": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, offline sessions are returned instead of active sessions.",
+			},
+			"sessions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_access": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"remember_me": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"clients": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getUserSessions(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, userId, clientId string, offline bool) ([]*keycloak.UserSession, error) {
+	if userId == "" {
+		if offline {
+			return keycloakClient.GetClientOfflineSessions(ctx, realmId, clientId)
+		}
+
+		return keycloakClient.GetClientUserSessions(ctx, realmId, clientId)
+	}
+
+	if offline {
+		if clientId == "" {
+			return nil, fmt.Errorf("client_id is required in order to fetch the offline sessions of a user")
+		}
+
+		return keycloakClient.GetUserOfflineSessions(ctx, realmId, userId, clientId)
+	}
+
+	sessions, err := keycloakClient.GetUserSessions(ctx, realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientId == "" {
+		return sessions, nil
+	}
+
+	var clientSessions []*keycloak.UserSession
+	for _, session := range sessions {
+		if _, ok := session.Clients[clientId]; ok {
+			clientSessions = append(clientSessions, session)
+		}
+	}
+
+	return clientSessions, nil
+}
+
+func dataSourceKeycloakUserSessionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	clientId := data.Get("client_id").(string)
+	offline := data.Get("offline").(bool)
+
+	sessions, err := getUserSessions(ctx, keycloakClient, realmId, userId, clientId, offline)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var sessionsData []interface{}
+	for _, session := range sessions {
+		sessionsData = append(sessionsData, map[string]interface{}{
+			"id":          session.Id,
+			"user_id":     session.UserId,
+			"username":    session.Username,
+			"ip_address":  session.IpAddress,
+			"start":       session.Start,
+			"last_access": session.LastAccess,
+			"remember_me": session.RememberMe,
+			"clients":     session.Clients,
+		})
+	}
+
+	data.SetId(strings.Join([]string{realmId, userId, clientId, strconv.FormatBool(offline)}, "/"))
+	data.Set("sessions", sessionsData)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceUserSessions_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	password := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	dataSourceName := "data.keycloak_user_sessions.user_sessions"
+	clientDataSourceName := "data.keycloak_user_sessions.client_sessions"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_initialPassword(username, password, clientId),
+				// logging in creates a session for the user
+				Check: testAccCheckKeycloakUserInitialPasswordLogin(username, password, clientId),
+			},
+			{
+				Config: testDataSourceKeycloakUserSessions_basic(username, password, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "sessions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sessions.0.user_id", "keycloak_user.user", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "sessions.0.username", username),
+					resource.TestCheckResourceAttr(clientDataSourceName, "sessions.#", "1"),
+				),
+			},
+			{
+				Config: testDataSourceKeycloakUserSessions_logout(username, password, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "sessions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUserSessions_basic(username, password, clientId string) string {
+	return fmt.Sprintf(`
+%s
+
+data "keycloak_user_sessions" "user_sessions" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+}
+
+data "keycloak_user_sessions" "client_sessions" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+}
+	`, testKeycloakUser_initialPassword(username, password, clientId))
+}
+
+func testDataSourceKeycloakUserSessions_logout(username, password, clientId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_user_logout" "logout" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+
+	triggers = {
+		incident = "1"
+	}
+}
+
+data "keycloak_user_sessions" "user_sessions" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+
+	depends_on = [
+		keycloak_user_logout.logout,
+	]
+}
+	`, testKeycloakUser_initialPassword(username, password, clientId))
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUsersRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A string contained in the username, first name, last name or email of the user.",
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"exact": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the username, email, first_name and last_name filters must match exactly.",
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Only users that have all of these attribute values will be returned.",
+				// keycloak splits the attribute query on spaces and colons, without any way of escaping them
+				ValidateDiagFunc: validateUsersSearchAttributes,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"email_verified": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"idp_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only users that are linked to this identity provider will be returned.",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email_verified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"required_actions": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateUsersSearchAttributes(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for key, value := range v.(map[string]interface{}) {
+		if strings.ContainsAny(key, " :") || strings.ContainsAny(value.(string), " :") {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid attributes entry",
+				Detail:   fmt.Sprintf(`the key and value of attribute "%s" can't contain spaces or colons, since keycloak uses them to separate the attributes within the search query`, key),
+				AttributePath: append(path, cty.IndexStep{
+					Key: cty.StringVal(key),
+				}),
+			})
+		}
+	}
+
+	return diags
+}
+
+func getUsersSearchParamsFromData(data *schema.ResourceData) map[string]string {
+	params := map[string]string{
+		"briefRepresentation": "false",
+	}
+
+	for attr, param := range map[string]string{
+		"search":     "search",
+		"username":   "username",
+		"email":      "email",
+		"first_name": "firstName",
+		"last_name":  "lastName",
+		"idp_alias":  "idpAlias",
+	} {
+		if v, ok := data.GetOk(attr); ok {
+			params[param] = v.(string)
+		}
+	}
+
+	if data.Get("exact").(bool) {
+		params["exact"] = "true"
+	}
+
+	if v, ok := data.GetOkExists("enabled"); ok {
+		params["enabled"] = strconv.FormatBool(v.(bool))
+	}
+
+	if v, ok := data.GetOkExists("email_verified"); ok {
+		params["emailVerified"] = strconv.FormatBool(v.(bool))
+	}
+
+	// keycloak expects attribute queries in the form of "key1:value1 key2:value2"
+	if v, ok := data.GetOk("attributes"); ok {
+		var query []string
+		for key, value := range v.(map[string]interface{}) {
+			query = append(query, fmt.Sprintf("%s:%s", key, value.(string)))
+		}
+		sort.Strings(query)
+
+		params["q"] = strings.Join(query, " ")
+	}
+
+	return params
+}
+
+func dataSourceKeycloakUsersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	users, err := keycloakClient.SearchUsers(ctx, realmId, getUsersSearchParamsFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var userIds []string
+	var usersData []interface{}
+	for _, user := range users {
+		attributes := map[string]string{}
+		for k, v := range user.Attributes {
+			attributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		usersData = append(usersData, map[string]interface{}{
+			"id":               user.Id,
+			"username":         user.Username,
+			"email":            user.Email,
+			"email_verified":   user.EmailVerified,
+			"first_name":       user.FirstName,
+			"last_name":        user.LastName,
+			"enabled":          user.Enabled,
+			"attributes":       attributes,
+			"required_actions": user.RequiredActions,
+		})
+		userIds = append(userIds, user.Id)
+	}
+
+	h := sha1.New()
+	h.Write([]byte(realmId + "/" + strings.Join(userIds, ",")))
+
+	data.SetId(base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	data.Set("users", usersData)
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceUsers_attributes(t *testing.T) {
+	t.Parallel()
+	usernamePrefix := acctest.RandomWithPrefix("tf-acc")
+	team := acctest.RandomWithPrefix("tf-acc")
+
+	dataSourceName := "data.keycloak_users.users"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUsers_attributes(usernamePrefix, team),
+				Check: resource.ComposeTestCheckFunc(
+					// users that are disabled or belong to another team must be filtered out
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.attributes.team", team),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUsers_exactEmail(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	email := acctest.RandomWithPrefix("tf-acc") + "@fakedomain.com"
+
+	dataSourceName := "data.keycloak_users.users"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUsers_exactEmail(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.id", "keycloak_user.user", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.email", email),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUsers_invalidAttributes(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakUsers_invalidAttributes("platform team"),
+				ExpectError: regexp.MustCompile("can't contain spaces or colons"),
+			},
+			{
+				Config:      testDataSourceKeycloakUsers_invalidAttributes("team:platform"),
+				ExpectError: regexp.MustCompile("can't contain spaces or colons"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUsers_attributes(usernamePrefix, team string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+// more users than fit on a single page
+resource "keycloak_user" "team_member" {
+	count    = 60
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-member-${count.index}"
+	enabled  = true
+
+	attributes = {
+		team = "%s"
+	}
+}
+
+resource "keycloak_user" "disabled_team_member" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-disabled"
+	enabled  = false
+
+	attributes = {
+		team = "%s"
+	}
+}
+
+resource "keycloak_user" "other_team_member" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-other"
+	enabled  = true
+
+	attributes = {
+		team = "other-%s"
+	}
+}
+
+data "keycloak_users" "users" {
+	realm_id = data.keycloak_realm.realm.id
+	enabled  = true
+
+	attributes = {
+		team = "%s"
+	}
+
+	depends_on = [
+		keycloak_user.team_member,
+		keycloak_user.disabled_team_member,
+		keycloak_user.other_team_member,
+	]
+}
+	`, testAccRealm.Realm, usernamePrefix, team, usernamePrefix, team, usernamePrefix, team, team)
+}
+
+func testDataSourceKeycloakUsers_exactEmail(username, email string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+	email    = "%s"
+}
+
+resource "keycloak_user" "similar_user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-similar"
+	email    = "similar-%s"
+}
+
+data "keycloak_users" "users" {
+	realm_id = data.keycloak_realm.realm.id
+	email    = keycloak_user.user.email
+	exact    = true
+
+	depends_on = [
+		keycloak_user.similar_user,
+	]
+}
+	`, testAccRealm.Realm, username, email, username, email)
+}
+
+func testDataSourceKeycloakUsers_invalidAttributes(team string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_users" "users" {
+	realm_id = data.keycloak_realm.realm.id
+
+	attributes = {
+		team = "%s"
+	}
+}
+	`, testAccRealm.Realm, team)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"strings"
@@ -69,6 +70,71 @@ func setIdentityProviderMapperData(data *schema.ResourceData, identityProviderMa
 	return nil
 }
 
+// identityProviderMapperKeyValue is the format of the claims and attributes that are matched by the advanced mappers.
+// Keycloak stores them within the mapper config as a json encoded list.
+type identityProviderMapperKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func identityProviderMapperKeyValueSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func getIdentityProviderMapperKeyValuesFromData(data *schema.ResourceData, attribute string) (string, error) {
+	keyValues := make([]identityProviderMapperKeyValue, 0)
+	for _, v := range data.Get(attribute).([]interface{}) {
+		keyValue := v.(map[string]interface{})
+		keyValues = append(keyValues, identityProviderMapperKeyValue{
+			Key:   keyValue["key"].(string),
+			Value: keyValue["value"].(string),
+		})
+	}
+
+	keyValuesJson, err := json.Marshal(keyValues)
+	if err != nil {
+		return "", err
+	}
+
+	return string(keyValuesJson), nil
+}
+
+func setIdentityProviderMapperKeyValuesData(data *schema.ResourceData, attribute string, configValue interface{}) error {
+	var keyValues []identityProviderMapperKeyValue
+	if s, ok := configValue.(string); ok && s != "" {
+		if err := json.Unmarshal([]byte(s), &keyValues); err != nil {
+			return fmt.Errorf("unable to parse %s of identity provider mapper: %s", attribute, err)
+		}
+	}
+
+	var keyValuesData []interface{}
+	for _, keyValue := range keyValues {
+		keyValuesData = append(keyValuesData, map[string]interface{}{
+			"key":   keyValue.Key,
+			"value": keyValue.Value,
+		})
+	}
+
+	return data.Set(attribute, keyValuesData)
+}
+
 func resourceKeycloakIdentityProviderMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -93,6 +159,43 @@ func resourceKeycloakIdentityProviderMapperImport(_ context.Context, d *schema.R
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceKeycloakIdentityProviderMapperTypedImport imports a mapper the same way resourceKeycloakIdentityProviderMapperImport does,
+// and verifies that the mapper is of the type that is managed by the resource. The expected type is built by the resource's
+// getter func, since it can depend on the type of the identity provider.
+func resourceKeycloakIdentityProviderMapperTypedImport(getIdentityProviderMapperFromData identityProviderMapperDataGetterFunc, setDataFromIdentityProviderMapper identityProviderMapperDataSetterFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		_, err := resourceKeycloakIdentityProviderMapperImport(ctx, d, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		realm := d.Get("realm").(string)
+		alias := d.Get("identity_provider_alias").(string)
+
+		identityProviderMapper, err := keycloakClient.GetIdentityProviderMapper(ctx, realm, alias, d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		if err = setDataFromIdentityProviderMapper(d, identityProviderMapper); err != nil {
+			return nil, err
+		}
+
+		expectedIdentityProviderMapper, err := getIdentityProviderMapperFromData(ctx, d, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		if identityProviderMapper.IdentityProviderMapper != expectedIdentityProviderMapper.IdentityProviderMapper {
+			return nil, fmt.Errorf("identity provider mapper %s is of type %s, but this resource manages mappers of type %s", d.Id(), identityProviderMapper.IdentityProviderMapper, expectedIdentityProviderMapper.IdentityProviderMapper)
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 func resourceKeycloakIdentityProviderMapperCreate(getIdentityProviderMapperFromData identityProviderMapperDataGetterFunc, setDataFromIdentityProviderMapper identityProviderMapperDataSetterFunc) func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		keycloakClient := meta.(*keycloak.KeycloakClient)
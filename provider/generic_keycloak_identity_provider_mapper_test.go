@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakIdentityProviderMapper_importWrongType(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakHardcodedRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakIdentityProviderMapper_importWrongType(alias),
+			},
+			{
+				ResourceName:      "keycloak_hardcoded_role_identity_provider_mapper.oidc",
+				ImportState:       true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId("keycloak_attribute_importer_identity_provider_mapper.oidc"),
+				ExpectError:       regexp.MustCompile("is of type oidc-user-attribute-idp-mapper, but this resource manages mappers of type oidc-hardcoded-role-idp-mapper"),
+			},
+		},
+	})
+}
+
+func getIdentityProviderMapperImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm"]
+		alias := rs.Primary.Attributes["identity_provider_alias"]
+		id := rs.Primary.ID
+
+		return fmt.Sprintf("%s/%s/%s", realm, alias, id), nil
+	}
+}
+
+func testAccCheckKeycloakIdentityProviderMapperHasType(resourceName, mapperType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		mapper, err := getKeycloakIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if mapper.IdentityProviderMapper != mapperType {
+			return fmt.Errorf("expected identity provider mapper %s to be of type %s, got %s", mapper.Name, mapperType, mapper.IdentityProviderMapper)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, configKey, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		mapper, err := getKeycloakIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		configValue := mapper.Config.ExtraConfig[configKey]
+		if configKey == "role" {
+			configValue = mapper.Config.Role
+		}
+
+		if configValue != value {
+			return fmt.Errorf("expected identity provider mapper %s to have config value %s for key %s, got %v", mapper.Name, value, configKey, configValue)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakIdentityProviderMapperDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm"]
+			alias := rs.Primary.Attributes["identity_provider_alias"]
+			id := rs.Primary.ID
+
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+			if mapper != nil {
+				return fmt.Errorf("identity provider mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakIdentityProviderMapperFromState(s *terraform.State, resourceName string) (*keycloak.IdentityProviderMapper, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realm := rs.Primary.Attributes["realm"]
+	alias := rs.Primary.Attributes["identity_provider_alias"]
+	id := rs.Primary.ID
+
+	mapper, err := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting identity provider mapper with id %s: %s", id, err)
+	}
+
+	return mapper, nil
+}
+
+func testKeycloakIdentityProviderMapper_importWrongType(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_hardcoded_role_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "hardcoded-role"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	role                    = "offline_access"
+}
+
+resource "keycloak_attribute_importer_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "attribute-importer"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	user_attribute          = "department"
+	claim_name              = "department"
+}
+	`, testAccRealm.Realm, alias)
+}
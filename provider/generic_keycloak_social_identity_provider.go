@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"dario.cat/mergo"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+)
+
+// socialIdentityProviderConfigAttribute maps a provider specific schema attribute to its key within the identity provider config
+type socialIdentityProviderConfigAttribute struct {
+	configKey string
+	schema    *schema.Schema
+}
+
+// resourceKeycloakSocialIdentityProvider builds a resource for one of the social identity providers that are built into Keycloak.
+// These providers only differ in a couple of provider specific config keys, which are passed in as configAttributes.
+func resourceKeycloakSocialIdentityProvider(providerId, displayName string, configAttributes map[string]socialIdentityProviderConfigAttribute) *schema.Resource {
+	socialSchema := map[string]*schema.Schema{
+		"alias": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     providerId,
+			Description: fmt.Sprintf("The alias uniquely identifies an identity provider and it is also used to build the redirect uri. Defaults to %s.", providerId),
+		},
+		"display_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The human-friendly name of the identity provider, used in the log in form.",
+		},
+		"provider_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     providerId,
+			Description: fmt.Sprintf("provider id, is always %s, unless you have a extended custom implementation", providerId),
+		},
+		"client_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Client ID.",
+		},
+		"client_secret": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Client Secret.",
+		},
+		"default_scopes": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("The scopes to be sent when asking for authorization. When empty, the default scopes of %s are used.", displayName),
+		},
+		"hide_on_login_page": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Hide On Login Page.",
+		},
+	}
+
+	for attribute, configAttribute := range configAttributes {
+		socialSchema[attribute] = configAttribute.schema
+	}
+
+	socialResource := resourceKeycloakIdentityProvider()
+	socialResource.Schema = mergeSchemas(socialResource.Schema, socialSchema)
+	socialResource.CreateContext = resourceKeycloakIdentityProviderCreate(getSocialIdentityProviderFromData(configAttributes), setSocialIdentityProviderData(configAttributes))
+	socialResource.ReadContext = resourceKeycloakIdentityProviderRead(setSocialIdentityProviderData(configAttributes))
+	socialResource.UpdateContext = resourceKeycloakIdentityProviderUpdate(getSocialIdentityProviderFromData(configAttributes), setSocialIdentityProviderData(configAttributes))
+
+	return socialResource
+}
+
+func getSocialIdentityProviderFromData(configAttributes map[string]socialIdentityProviderConfigAttribute) identityProviderDataGetterFunc {
+	return func(data *schema.ResourceData, keycloakVersion *version.Version) (*keycloak.IdentityProvider, error) {
+		rec, defaultConfig := getIdentityProviderFromData(data, keycloakVersion)
+		rec.ProviderId = data.Get("provider_id").(string)
+
+		socialIdentityProviderConfig := &keycloak.IdentityProviderConfig{
+			ClientId:     data.Get("client_id").(string),
+			ClientSecret: data.Get("client_secret").(string),
+			DefaultScope: data.Get("default_scopes").(string),
+
+			//since keycloak v26 moved to IdentityProvider - still here fore backward compatibility
+			HideOnLoginPage: types.KeycloakBoolQuoted(data.Get("hide_on_login_page").(bool)),
+		}
+
+		if err := mergo.Merge(socialIdentityProviderConfig, defaultConfig); err != nil {
+			return nil, err
+		}
+
+		if socialIdentityProviderConfig.ExtraConfig == nil {
+			socialIdentityProviderConfig.ExtraConfig = map[string]interface{}{}
+		}
+
+		for attribute, configAttribute := range configAttributes {
+			var value string
+			if configAttribute.schema.Type == schema.TypeBool {
+				value = strconv.FormatBool(data.Get(attribute).(bool))
+			} else {
+				value = data.Get(attribute).(string)
+			}
+
+			// the stack overflow key is part of the config that is shared among all identity providers
+			if configAttribute.configKey == "key" {
+				socialIdentityProviderConfig.Key = value
+				continue
+			}
+
+			// empty values are sent as well, so values that have been removed from the configuration are removed in keycloak too
+			socialIdentityProviderConfig.ExtraConfig[configAttribute.configKey] = value
+		}
+
+		rec.Config = socialIdentityProviderConfig
+
+		return rec, nil
+	}
+}
+
+func setSocialIdentityProviderData(configAttributes map[string]socialIdentityProviderConfigAttribute) identityProviderDataSetterFunc {
+	return func(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, keycloakVersion *version.Version) error {
+		setIdentityProviderData(data, identityProvider, keycloakVersion)
+		data.Set("provider_id", identityProvider.ProviderId)
+		data.Set("client_id", identityProvider.Config.ClientId)
+		data.Set("default_scopes", identityProvider.Config.DefaultScope)
+
+		for attribute, configAttribute := range configAttributes {
+			var value string
+			if configAttribute.configKey == "key" {
+				value = identityProvider.Config.Key
+			} else if v, ok := identityProvider.Config.ExtraConfig[configAttribute.configKey].(string); ok {
+				value = v
+			}
+
+			if configAttribute.schema.Type == schema.TypeBool {
+				boolValue, _ := strconv.ParseBool(value)
+				data.Set(attribute, boolValue)
+			} else {
+				data.Set(attribute, value)
+			}
+		}
+
+		if keycloakVersion.LessThan(keycloak.Version_26.AsVersion()) {
+			// Since keycloak v26 the attribute "hideOnLoginPage" is not part of the identity provider config anymore!
+			data.Set("hide_on_login_page", identityProvider.Config.HideOnLoginPage)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// TestAccKeycloakSocialIdentityProvider_basic covers the attributes that are shared by every social identity provider, as
+// well as the provider specific config attributes.
+func TestAccKeycloakSocialIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+
+	socialIdentityProviders := []struct {
+		resourceType string
+		providerId   string
+		// the provider specific attributes, and the config values they are expected to be stored as
+		extraArguments string
+		expectedConfig map[string]string
+		// the provider specific attributes after the update, and the config values they are expected to be stored as
+		updatedExtraArguments string
+		updatedExpectedConfig map[string]string
+		// the test is skipped for this version of keycloak and later
+		maxVersion keycloak.Version
+	}{
+		{resourceType: "keycloak_bitbucket_identity_provider", providerId: "bitbucket"},
+		{
+			resourceType:          "keycloak_facebook_identity_provider",
+			providerId:            "facebook",
+			extraArguments:        `fetched_fields = "birthday"`,
+			expectedConfig:        map[string]string{"fetchedFields": "birthday"},
+			updatedExtraArguments: `fetched_fields = "birthday,gender"`,
+			updatedExpectedConfig: map[string]string{"fetchedFields": "birthday,gender"},
+		},
+		{
+			resourceType: "keycloak_github_identity_provider",
+			providerId:   "github",
+			extraArguments: `base_url = "https://github.example.com"
+	api_url  = "https://github.example.com/api/v3"`,
+			expectedConfig: map[string]string{"baseUrl": "https://github.example.com", "apiUrl": "https://github.example.com/api/v3"},
+			updatedExtraArguments: `base_url = "https://github2.example.com"
+	api_url  = "https://github2.example.com/api/v3"`,
+			updatedExpectedConfig: map[string]string{"baseUrl": "https://github2.example.com", "apiUrl": "https://github2.example.com/api/v3"},
+		},
+		{resourceType: "keycloak_gitlab_identity_provider", providerId: "gitlab"},
+		// the instagram identity provider is deprecated, and has to be enabled through a feature flag since keycloak 22
+		{resourceType: "keycloak_instagram_identity_provider", providerId: "instagram", maxVersion: keycloak.Version_22},
+		{resourceType: "keycloak_linkedin_identity_provider", providerId: "linkedin-openid-connect"},
+		{
+			resourceType:          "keycloak_microsoft_identity_provider",
+			providerId:            "microsoft",
+			extraArguments:        `tenant_id = "5f2f5a4e-3c9e-4d1b-9d3c-0b1b2c3d4e5f"`,
+			expectedConfig:        map[string]string{"tenantId": "5f2f5a4e-3c9e-4d1b-9d3c-0b1b2c3d4e5f"},
+			updatedExtraArguments: `tenant_id = "organizations"`,
+			updatedExpectedConfig: map[string]string{"tenantId": "organizations"},
+		},
+		{
+			resourceType:          "keycloak_openshift_v4_identity_provider",
+			providerId:            "openshift-v4",
+			extraArguments:        `base_url = "https://openshift.example.com"`,
+			expectedConfig:        map[string]string{"baseUrl": "https://openshift.example.com"},
+			updatedExtraArguments: `base_url = "https://openshift2.example.com"`,
+			updatedExpectedConfig: map[string]string{"baseUrl": "https://openshift2.example.com"},
+		},
+		{
+			resourceType:          "keycloak_paypal_identity_provider",
+			providerId:            "paypal",
+			extraArguments:        `sandbox = true`,
+			expectedConfig:        map[string]string{"sandbox": "true"},
+			updatedExtraArguments: `sandbox = false`,
+			updatedExpectedConfig: map[string]string{"sandbox": "false"},
+		},
+		{
+			resourceType:          "keycloak_stackoverflow_identity_provider",
+			providerId:            "stackoverflow",
+			extraArguments:        `key = "stack-apps-key"`,
+			expectedConfig:        map[string]string{"key": "stack-apps-key"},
+			updatedExtraArguments: `key = "another-stack-apps-key"`,
+			updatedExpectedConfig: map[string]string{"key": "another-stack-apps-key"},
+		},
+		{resourceType: "keycloak_twitter_identity_provider", providerId: "twitter"},
+	}
+
+	for _, socialIdentityProvider := range socialIdentityProviders {
+		socialIdentityProvider := socialIdentityProvider
+
+		t.Run(socialIdentityProvider.resourceType, func(t *testing.T) {
+			t.Parallel()
+
+			if socialIdentityProvider.maxVersion != "" {
+				if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, socialIdentityProvider.maxVersion); ok {
+					t.Skip()
+				}
+			}
+
+			alias := acctest.RandomWithPrefix("tf-acc")
+			resourceName := socialIdentityProvider.resourceType + ".idp"
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: testAccProviderFactories,
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy(socialIdentityProvider.resourceType),
+				Steps: []resource.TestStep{
+					{
+						Config: testKeycloakSocialIdentityProvider_basic(socialIdentityProvider.resourceType, alias, "Social", socialIdentityProvider.extraArguments),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckKeycloakSocialIdentityProviderExists(resourceName, socialIdentityProvider.providerId),
+							testAccCheckKeycloakSocialIdentityProviderHasConfig(resourceName, socialIdentityProvider.expectedConfig),
+							resource.TestCheckResourceAttr(resourceName, "display_name", "Social"),
+						),
+					},
+					{
+						ResourceName:            resourceName,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateIdPrefix:     testAccRealm.Realm + "/",
+						ImportStateVerifyIgnore: []string{"client_secret"},
+					},
+					{
+						Config: testKeycloakSocialIdentityProvider_basic(socialIdentityProvider.resourceType, alias, "Social Updated", socialIdentityProvider.updatedExtraArguments),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckKeycloakSocialIdentityProviderExists(resourceName, socialIdentityProvider.providerId),
+							testAccCheckKeycloakSocialIdentityProviderHasConfig(resourceName, socialIdentityProvider.updatedExpectedConfig),
+							resource.TestCheckResourceAttr(resourceName, "display_name", "Social Updated"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderExists(resourceName, providerId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		idp, err := getKeycloakSocialIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if idp.ProviderId != providerId {
+			return fmt.Errorf("expected identity provider %s to have provider id %s, got %s", idp.Alias, providerId, idp.ProviderId)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderHasConfig(resourceName string, expectedConfig map[string]string) resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	for configKey, value := range expectedConfig {
+		checks = append(checks, testAccCheckKeycloakSocialIdentityProviderHasConfigValue(resourceName, configKey, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccCheckKeycloakSocialIdentityProviderHasConfigValue(resourceName, configKey, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		idp, err := getKeycloakSocialIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		configValue := idp.Config.ExtraConfig[configKey]
+		if configKey == "key" {
+			configValue = idp.Config.Key
+		}
+
+		if configValue != value {
+			return fmt.Errorf("expected identity provider %s to have config value %s for key %s, got %v", idp.Alias, value, configKey, configValue)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm"]
+
+			idp, _ := keycloakClient.GetIdentityProvider(testCtx, realm, id)
+			if idp != nil {
+				return fmt.Errorf("identity provider with alias %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakSocialIdentityProviderFromState(s *terraform.State, resourceName string) (*keycloak.IdentityProvider, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realm := rs.Primary.Attributes["realm"]
+	alias := rs.Primary.Attributes["alias"]
+
+	idp, err := keycloakClient.GetIdentityProvider(testCtx, realm, alias)
+	if err != nil {
+		return nil, fmt.Errorf("error getting identity provider with alias %s: %s", alias, err)
+	}
+
+	return idp, nil
+}
+
+func testKeycloakSocialIdentityProvider_basic(resourceType, alias, displayName, extraArguments string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "%s" "idp" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	display_name  = "%s"
+	client_id     = "example_id"
+	client_secret = "example_secret"
+
+	%s
+}
+	`, testAccRealm.Realm, resourceType, alias, displayName, extraArguments)
+}
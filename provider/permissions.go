@@ -2,11 +2,28 @@ package provider
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
+// checkAdminPermissionsV1 returns an error when fine-grained admin permissions (v2) are enabled for the realm, since the
+// v1 permissions managed through the realm-management client are not available anymore in that case.
+func checkAdminPermissionsV1(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string) error {
+	enabled, err := keycloakClient.AdminPermissionsEnabled(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	if enabled {
+		return fmt.Errorf("realm %s uses fine-grained admin permissions v2, which have to be managed using keycloak_admin_permission", realmId)
+	}
+
+	return nil
+}
+
 func setOpenidClientScopePermissionPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, realmManagementClientId string, authorizationPermissionId string, scopeDataSet *schema.Set) error {
 	var policies []string
 
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakAdminPermission() *schema.Resource {
+	var resourceTypes []string
+	for resourceType := range keycloak.AdminPermissionResourceTypeScopes {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakAdminPermissionCreate,
+		ReadContext:   resourceKeycloakAdminPermissionRead,
+		DeleteContext: resourceKeycloakAdminPermissionDelete,
+		UpdateContext: resourceKeycloakAdminPermissionUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakAdminPermissionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the admin-permissions client that holds this permission.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(resourceTypes, false),
+				Description:  "The type of the resources this permission applies to.",
+			},
+			"scopes": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				MinItems:    1,
+				Description: "The names of the scopes of the resource type that are granted by this permission.",
+			},
+			"resources": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The ids of the users, groups, clients or roles this permission applies to. When empty, the permission applies to all resources of the resource type.",
+			},
+			"policies": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The ids of the policies of the admin-permissions client that are evaluated by this permission.",
+			},
+			"decision_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+				Default:      "UNANIMOUS",
+			},
+		},
+	}
+}
+
+func getAdminPermissionFromData(data *schema.ResourceData) (*keycloak.AdminPermission, error) {
+	resourceType := data.Get("resource_type").(string)
+
+	scopes := interfaceSliceToStringSlice(data.Get("scopes").(*schema.Set).List())
+	for _, scope := range scopes {
+		if !stringSliceContains(keycloak.AdminPermissionResourceTypeScopes[resourceType], scope) {
+			return nil, fmt.Errorf("scope %s is not supported by resource type %s, supported scopes are %s", scope, resourceType, strings.Join(keycloak.AdminPermissionResourceTypeScopes[resourceType], ", "))
+		}
+	}
+
+	return &keycloak.AdminPermission{
+		Id:               data.Id(),
+		RealmId:          data.Get("realm_id").(string),
+		ResourceServerId: data.Get("resource_server_id").(string),
+		Name:             data.Get("name").(string),
+		Description:      data.Get("description").(string),
+		DecisionStrategy: data.Get("decision_strategy").(string),
+		ResourceType:     resourceType,
+		Resources:        interfaceSliceToStringSlice(data.Get("resources").(*schema.Set).List()),
+		Scopes:           scopes,
+		Policies:         interfaceSliceToStringSlice(data.Get("policies").(*schema.Set).List()),
+		Type:             "scope",
+	}, nil
+}
+
+func setAdminPermissionData(data *schema.ResourceData, permission *keycloak.AdminPermission) {
+	data.SetId(permission.Id)
+	data.Set("realm_id", permission.RealmId)
+	data.Set("resource_server_id", permission.ResourceServerId)
+	data.Set("name", permission.Name)
+	data.Set("description", permission.Description)
+	data.Set("decision_strategy", permission.DecisionStrategy)
+	data.Set("resource_type", permission.ResourceType)
+	data.Set("resources", permission.Resources)
+	data.Set("scopes", permission.Scopes)
+	data.Set("policies", permission.Policies)
+}
+
+func resourceKeycloakAdminPermissionCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_26_2); !ok && err == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "this resource requires Keycloak v26.2 or higher",
+		}}
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission, err := getAdminPermissionFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	adminPermissionsClient, err := keycloakClient.GetAdminPermissionsClient(ctx, permission.RealmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	permission.ResourceServerId = adminPermissionsClient.Id
+
+	err = keycloakClient.NewAdminPermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setAdminPermissionData(data, permission)
+
+	return resourceKeycloakAdminPermissionRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminPermissionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	permission, err := keycloakClient.GetAdminPermission(ctx, realmId, resourceServerId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setAdminPermissionData(data, permission)
+
+	return nil
+}
+
+func resourceKeycloakAdminPermissionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	permission, err := getAdminPermissionFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateAdminPermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakAdminPermissionRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminPermissionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteAdminPermission(ctx, realmId, resourceServerId, data.Id()))
+}
+
+func resourceKeycloakAdminPermissionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{permissionId}}")
+	}
+
+	adminPermissionsClient, err := keycloakClient.GetAdminPermissionsClient(ctx, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("resource_server_id", adminPermissionsClient.Id)
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakAdminPermission_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_2)
+
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdminPermissionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdminPermission_basic(realmName, username, `["view"]`, "[]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdminPermissionExists("keycloak_admin_permission.permission"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "admin_permissions_enabled", "true"),
+					resource.TestCheckResourceAttr("keycloak_admin_permission.permission", "resource_type", "Users"),
+					resource.TestCheckResourceAttr("keycloak_admin_permission.permission", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_admin_permission.permission", "resources.#", "0"),
+					resource.TestCheckResourceAttr("keycloak_admin_permission.permission", "policies.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_admin_permission.permission",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getAdminPermissionImportId("keycloak_admin_permission.permission"),
+			},
+			{
+				Config: testKeycloakAdminPermission_basic(realmName, username, `["view", "manage"]`, "[keycloak_user.user.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdminPermissionExists("keycloak_admin_permission.permission"),
+					resource.TestCheckResourceAttr("keycloak_admin_permission.permission", "scopes.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_admin_permission.permission", "resources.#", "1"),
+					resource.TestCheckResourceAttrPair("keycloak_admin_permission.permission", "resources.0", "keycloak_user.user", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdminPermission_invalidScope(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_2)
+
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAdminPermission_basic(realmName, username, `["view-members"]`, "[]"),
+				ExpectError: regexp.MustCompile("scope view-members is not supported by resource type Users"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAdminPermissionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getAdminPermissionFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakAdminPermissionDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_admin_permission" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			resourceServerId := rs.Primary.Attributes["resource_server_id"]
+
+			permission, _ := keycloakClient.GetAdminPermission(testCtx, realmId, resourceServerId, rs.Primary.ID)
+			if permission != nil {
+				return fmt.Errorf("admin permission with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getAdminPermissionFromState(s *terraform.State, resourceName string) (*keycloak.AdminPermission, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realmId := rs.Primary.Attributes["realm_id"]
+	resourceServerId := rs.Primary.Attributes["resource_server_id"]
+
+	permission, err := keycloakClient.GetAdminPermission(testCtx, realmId, resourceServerId, rs.Primary.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting admin permission with id %s: %s", rs.Primary.ID, err)
+	}
+
+	return permission, nil
+}
+
+func getAdminPermissionImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.ID), nil
+	}
+}
+
+func testKeycloakAdminPermission_basic(realmName, username, scopes, resources string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                     = "%s"
+	admin_permissions_enabled = true
+}
+
+data "keycloak_openid_client" "admin_permissions" {
+	realm_id  = keycloak_realm.realm.id
+	client_id = "admin-permissions"
+
+	depends_on = [keycloak_realm.realm]
+}
+
+resource "keycloak_user" "user" {
+	realm_id = keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_openid_client_user_policy" "policy" {
+	resource_server_id = data.keycloak_openid_client.admin_permissions.id
+	realm_id           = keycloak_realm.realm.id
+	name               = "user-policy"
+	users              = [keycloak_user.user.id]
+	logic              = "POSITIVE"
+	decision_strategy  = "UNANIMOUS"
+}
+
+resource "keycloak_admin_permission" "permission" {
+	realm_id      = keycloak_realm.realm.id
+	name          = "manage-users"
+	resource_type = "Users"
+	scopes        = %s
+	resources     = %s
+	policies      = [keycloak_openid_client_user_policy.policy.id]
+}
+	`, realmName, username, scopes, resources)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakAdvancedAttributeToRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attributes": identityProviderMapperKeyValueSchema("The attributes that must be present in the assertion. All attributes must match for the role to be granted."),
+		"attribute_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When true, the values of the attributes are interpreted as regular expressions.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getAdvancedAttributeToRoleIdentityProviderMapperFromData, setAdvancedAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setAdvancedAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getAdvancedAttributeToRoleIdentityProviderMapperFromData, setAdvancedAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.Importer.StateContext = resourceKeycloakIdentityProviderMapperTypedImport(getAdvancedAttributeToRoleIdentityProviderMapperFromData, setAdvancedAttributeToRoleIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getAdvancedAttributeToRoleIdentityProviderMapperFromData(_ context.Context, data *schema.ResourceData, _ interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _ := getIdentityProviderMapperFromData(data)
+
+	attributes, err := getIdentityProviderMapperKeyValuesFromData(data, "attributes")
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "saml-advanced-role-idp-mapper"
+	rec.Config.Role = data.Get("role").(string)
+	rec.Config.ExtraConfig["attributes"] = attributes
+	rec.Config.ExtraConfig["are.attribute.values.regex"] = strconv.FormatBool(data.Get("attribute_values_regex").(bool))
+
+	return rec, nil
+}
+
+func setAdvancedAttributeToRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	data.Set("role", identityProviderMapper.Config.Role)
+
+	if err := setIdentityProviderMapperKeyValuesData(data, "attributes", identityProviderMapper.Config.ExtraConfig["attributes"]); err != nil {
+		return err
+	}
+
+	valuesRegex, _ := identityProviderMapper.Config.ExtraConfig["are.attribute.values.regex"].(string)
+	valuesRegexBool, _ := strconv.ParseBool(valuesRegex)
+	data.Set("attribute_values_regex", valuesRegexBool)
+
+	return nil
+}
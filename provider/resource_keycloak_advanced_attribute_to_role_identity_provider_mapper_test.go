@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakAdvancedAttributeToRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	role := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_advanced_attribute_to_role_identity_provider_mapper.saml"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_advanced_attribute_to_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedAttributeToRoleIdentityProviderMapper_basic(alias, role, "engineering", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperHasType(resourceName, "saml-advanced-role-idp-mapper"),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "role", role),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "attributes", `[{"key":"department","value":"engineering"}]`),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "are.attribute.values.regex", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId(resourceName),
+			},
+			{
+				Config: testKeycloakAdvancedAttributeToRoleIdentityProviderMapper_basic(alias, role, "eng.*", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "attributes", `[{"key":"department","value":"eng.*"}]`),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "are.attribute.values.regex", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakAdvancedAttributeToRoleIdentityProviderMapper_basic(alias, role, attributeValue string, regex bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_advanced_attribute_to_role_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "advanced-attribute-to-role"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	role                    = keycloak_role.role.name
+	attribute_values_regex  = %t
+
+	attributes {
+		key   = "department"
+		value = "%s"
+	}
+}
+	`, testAccRealm.Realm, alias, role, regex, attributeValue)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakAdvancedClaimToGroupIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"claims": identityProviderMapperKeyValueSchema("The claims that must be present in the token. All claims must match for the user to be added to the group."),
+		"claim_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When true, the values of the claims are interpreted as regular expressions.",
+		},
+		"group": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The path of the group, such as /parent/child",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getAdvancedClaimToGroupIdentityProviderMapperFromData, setAdvancedClaimToGroupIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setAdvancedClaimToGroupIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getAdvancedClaimToGroupIdentityProviderMapperFromData, setAdvancedClaimToGroupIdentityProviderMapperData)
+	genericMapperResource.Importer.StateContext = resourceKeycloakIdentityProviderMapperTypedImport(getAdvancedClaimToGroupIdentityProviderMapperFromData, setAdvancedClaimToGroupIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getAdvancedClaimToGroupIdentityProviderMapperFromData(_ context.Context, data *schema.ResourceData, _ interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _ := getIdentityProviderMapperFromData(data)
+
+	claims, err := getIdentityProviderMapperKeyValuesFromData(data, "claims")
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "oidc-advanced-group-idp-mapper"
+	rec.Config.ExtraConfig["group"] = data.Get("group").(string)
+	rec.Config.ExtraConfig["claims"] = claims
+	rec.Config.ExtraConfig["are.claim.values.regex"] = strconv.FormatBool(data.Get("claim_values_regex").(bool))
+
+	return rec, nil
+}
+
+func setAdvancedClaimToGroupIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	data.Set("group", identityProviderMapper.Config.ExtraConfig["group"])
+
+	if err := setIdentityProviderMapperKeyValuesData(data, "claims", identityProviderMapper.Config.ExtraConfig["claims"]); err != nil {
+		return err
+	}
+
+	valuesRegex, _ := identityProviderMapper.Config.ExtraConfig["are.claim.values.regex"].(string)
+	valuesRegexBool, _ := strconv.ParseBool(valuesRegex)
+	data.Set("claim_values_regex", valuesRegexBool)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakAdvancedClaimToGroupIdentityProviderMapper_basic(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_22); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_advanced_claim_to_group_identity_provider_mapper.oidc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_advanced_claim_to_group_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedClaimToGroupIdentityProviderMapper_basic(alias, groupName, "engineering", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperHasType(resourceName, "oidc-advanced-group-idp-mapper"),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "group", fmt.Sprintf("/%s/child", groupName)),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "claims", `[{"key":"department","value":"engineering"}]`),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "are.claim.values.regex", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId(resourceName),
+			},
+			{
+				Config: testKeycloakAdvancedClaimToGroupIdentityProviderMapper_basic(alias, groupName, "eng.*", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "claims", `[{"key":"department","value":"eng.*"}]`),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "are.claim.values.regex", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakAdvancedClaimToGroupIdentityProviderMapper_basic(alias, groupName, claimValue string, regex bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_group" "parent" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_group" "child" {
+	realm_id  = data.keycloak_realm.realm.id
+	parent_id = keycloak_group.parent.id
+	name      = "child"
+}
+
+resource "keycloak_advanced_claim_to_group_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "advanced-claim-to-group"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	group                   = keycloak_group.child.path
+	claim_values_regex      = %t
+
+	claims {
+		key   = "department"
+		value = "%s"
+	}
+}
+	`, testAccRealm.Realm, alias, groupName, regex, claimValue)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakAdvancedClaimToRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"claims": identityProviderMapperKeyValueSchema("The claims that must be present in the token. All claims must match for the role to be granted."),
+		"claim_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When true, the values of the claims are interpreted as regular expressions.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getAdvancedClaimToRoleIdentityProviderMapperFromData, setAdvancedClaimToRoleIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setAdvancedClaimToRoleIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getAdvancedClaimToRoleIdentityProviderMapperFromData, setAdvancedClaimToRoleIdentityProviderMapperData)
+	genericMapperResource.Importer.StateContext = resourceKeycloakIdentityProviderMapperTypedImport(getAdvancedClaimToRoleIdentityProviderMapperFromData, setAdvancedClaimToRoleIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getAdvancedClaimToRoleIdentityProviderMapperFromData(_ context.Context, data *schema.ResourceData, _ interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _ := getIdentityProviderMapperFromData(data)
+
+	claims, err := getIdentityProviderMapperKeyValuesFromData(data, "claims")
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "oidc-advanced-role-idp-mapper"
+	rec.Config.Role = data.Get("role").(string)
+	rec.Config.ExtraConfig["claims"] = claims
+	rec.Config.ExtraConfig["are.claim.values.regex"] = strconv.FormatBool(data.Get("claim_values_regex").(bool))
+
+	return rec, nil
+}
+
+func setAdvancedClaimToRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	data.Set("role", identityProviderMapper.Config.Role)
+
+	if err := setIdentityProviderMapperKeyValuesData(data, "claims", identityProviderMapper.Config.ExtraConfig["claims"]); err != nil {
+		return err
+	}
+
+	valuesRegex, _ := identityProviderMapper.Config.ExtraConfig["are.claim.values.regex"].(string)
+	valuesRegexBool, _ := strconv.ParseBool(valuesRegex)
+	data.Set("claim_values_regex", valuesRegexBool)
+
+	return nil
+}
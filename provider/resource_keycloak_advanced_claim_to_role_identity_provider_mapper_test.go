@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	role := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_advanced_claim_to_role_identity_provider_mapper.oidc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_advanced_claim_to_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, role),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperHasType(resourceName, "oidc-advanced-role-idp-mapper"),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "role", role),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "claims", `[{"key":"department","value":"engineering"},{"key":"level","value":"senior"}]`),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "are.claim.values.regex", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdvancedClaimToRoleIdentityProviderMapper_update(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	role := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_advanced_claim_to_role_identity_provider_mapper.oidc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_advanced_claim_to_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, role),
+				Check:  testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "are.claim.values.regex", "false"),
+			},
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_regex(alias, role),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "claims", `[{"key":"department","value":"eng.*"}]`),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "are.claim.values.regex", "true"),
+					resource.TestCheckResourceAttr(resourceName, "claims.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, role string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_advanced_claim_to_role_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "advanced-claim-to-role"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	role                    = keycloak_role.role.name
+
+	claims {
+		key   = "department"
+		value = "engineering"
+	}
+
+	claims {
+		key   = "level"
+		value = "senior"
+	}
+}
+	`, testAccRealm.Realm, alias, role)
+}
+
+func testKeycloakAdvancedClaimToRoleIdentityProviderMapper_regex(alias, role string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_advanced_claim_to_role_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "advanced-claim-to-role"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	role                    = keycloak_role.role.name
+	claim_values_regex      = true
+
+	claims {
+		key   = "department"
+		value = "eng.*"
+	}
+}
+	`, testAccRealm.Realm, alias, role)
+}
@@ -38,6 +38,7 @@ func resourceKeycloakAttributeImporterIdentityProviderMapper() *schema.Resource
 	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getAttributeImporterIdentityProviderMapperFromData, setAttributeImporterIdentityProviderMapperData)
 	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setAttributeImporterIdentityProviderMapperData)
 	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getAttributeImporterIdentityProviderMapperFromData, setAttributeImporterIdentityProviderMapperData)
+	genericMapperResource.Importer.StateContext = resourceKeycloakIdentityProviderMapperTypedImport(getAttributeImporterIdentityProviderMapperFromData, setAttributeImporterIdentityProviderMapperData)
 	return genericMapperResource
 }
 
@@ -48,6 +48,7 @@ func resourceKeycloakAttributeToRoleIdentityProviderMapper() *schema.Resource {
 	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getAttributeToRoleIdentityProviderMapperFromData, setAttributeToRoleIdentityProviderMapperData)
 	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setAttributeToRoleIdentityProviderMapperData)
 	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getAttributeToRoleIdentityProviderMapperFromData, setAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.Importer.StateContext = resourceKeycloakIdentityProviderMapperTypedImport(getAttributeToRoleIdentityProviderMapperFromData, setAttributeToRoleIdentityProviderMapperData)
 	return genericMapperResource
 }
 
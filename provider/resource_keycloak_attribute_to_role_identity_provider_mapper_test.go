@@ -27,6 +27,12 @@ func TestAccKeycloakAttributeToRoleIdentityProviderMapper_basic(t *testing.T) {
 				Config: testKeycloakAttributeToRoleIdentityProviderMapper_basic(alias, mapperName, role, claimName, claimValue),
 				Check:  testAccCheckKeycloakAttributeToRoleIdentityProviderMapperExists("keycloak_attribute_to_role_identity_provider_mapper.oidc"),
 			},
+			{
+				ResourceName:      "keycloak_attribute_to_role_identity_provider_mapper.oidc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId("keycloak_attribute_to_role_identity_provider_mapper.oidc"),
+			},
 		},
 	})
 }
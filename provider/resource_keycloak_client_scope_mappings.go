@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakClientScopeMappings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakClientScopeMappingsReconcile,
+		ReadContext:   resourceKeycloakClientScopeMappingsRead,
+		UpdateContext: resourceKeycloakClientScopeMappingsReconcile,
+		DeleteContext: resourceKeycloakClientScopeMappingsDelete,
+		// This resource can be imported using {{realm}}/client/{{client_id}} or {{realm}}/client-scope/{{client_scope_id}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakClientScopeMappingsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The client that the scope mappings are configured for. Cannot be used at the same time as client_scope_id.",
+				ExactlyOneOf: []string{"client_id", "client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The client scope that the scope mappings are configured for. Cannot be used at the same time as client_id.",
+				ExactlyOneOf: []string{"client_id", "client_scope_id"},
+			},
+			"realm_roles": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Names of the realm roles that are in scope.",
+			},
+			"client_roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The id of the client that owns the roles.",
+						},
+						"roles": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Required:    true,
+							Description: "Names of the client roles that are in scope.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func clientScopeMappingsId(realmId, clientId, clientScopeId string) string {
+	if clientId != "" {
+		return fmt.Sprintf("%s/client/%s", realmId, clientId)
+	}
+
+	return fmt.Sprintf("%s/client-scope/%s", realmId, clientScopeId)
+}
+
+// resolve the role names configured via terraform into roles, grouped by realm and client.
+// roles that don't exist anymore are skipped when ignoreMissing is true, which is required when cleaning up
+func getClientScopeMappingsFromData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, ignoreMissing bool) (*roleMapping, error) {
+	realmId := data.Get("realm_id").(string)
+
+	mapping := &roleMapping{
+		clientRoles: make(map[string][]*keycloak.Role),
+	}
+
+	getRole := func(roleClientId, roleName string) (*keycloak.Role, error) {
+		role, err := keycloakClient.GetRoleByName(ctx, realmId, roleClientId, roleName)
+		if err != nil {
+			if keycloak.ErrorIs404(err) && ignoreMissing {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		return role, nil
+	}
+
+	for _, roleName := range data.Get("realm_roles").(*schema.Set).List() {
+		role, err := getRole("", roleName.(string))
+		if err != nil {
+			return nil, err
+		}
+		if role != nil {
+			mapping.realmRoles = append(mapping.realmRoles, role)
+		}
+	}
+
+	for _, clientRolesData := range data.Get("client_roles").(*schema.Set).List() {
+		clientRoles := clientRolesData.(map[string]interface{})
+		roleClientId := clientRoles["client_id"].(string)
+
+		for _, roleName := range clientRoles["roles"].(*schema.Set).List() {
+			role, err := getRole(roleClientId, roleName.(string))
+			if err != nil {
+				return nil, err
+			}
+			if role != nil {
+				mapping.clientRoles[roleClientId] = append(mapping.clientRoles[roleClientId], role)
+			}
+		}
+	}
+
+	return mapping, nil
+}
+
+func addClientScopeMappings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId, clientScopeId string, clientRolesToAdd map[string][]*keycloak.Role, realmRolesToAdd []*keycloak.Role) error {
+	if len(realmRolesToAdd) != 0 {
+		err := keycloakClient.AddRealmRoleScopeMappings(ctx, realmId, clientId, clientScopeId, realmRolesToAdd)
+		if err != nil {
+			return err
+		}
+	}
+
+	for roleClientId, roles := range clientRolesToAdd {
+		if len(roles) != 0 {
+			err := keycloakClient.AddClientRoleScopeMappings(ctx, realmId, clientId, clientScopeId, roleClientId, roles)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func removeClientScopeMappings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId, clientScopeId string, clientRolesToRemove map[string][]*keycloak.Role, realmRolesToRemove []*keycloak.Role) error {
+	if len(realmRolesToRemove) != 0 {
+		err := keycloakClient.RemoveRealmRoleScopeMappings(ctx, realmId, clientId, clientScopeId, realmRolesToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	for roleClientId, roles := range clientRolesToRemove {
+		if len(roles) != 0 {
+			err := keycloakClient.RemoveClientRoleScopeMappings(ctx, realmId, clientId, clientScopeId, roleClientId, roles)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakClientScopeMappingsReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	var diags diag.Diagnostics
+
+	// scope mappings are ignored by keycloak if the client is allowed to use the full scope
+	if clientId != "" {
+		client, err := keycloakClient.GetGenericClient(ctx, realmId, clientId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if client.FullScopeAllowed {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Scope mappings have no effect while full_scope_allowed is enabled",
+				Detail:   fmt.Sprintf("Client %s has full_scope_allowed enabled, so every role of the user will be included in its tokens regardless of these scope mappings.", client.ClientId),
+			})
+		}
+	}
+
+	tfRoles, err := getClientScopeMappingsFromData(ctx, keycloakClient, data, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingRoles, err := keycloakClient.GetRoleScopeMappings(ctx, realmId, clientId, clientScopeId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// sort into roles we need to add and roles we need to remove
+	updates := calculateRoleMappingUpdates(tfRoles, intoRoleMapping(existingRoles))
+
+	err = addClientScopeMappings(ctx, keycloakClient, realmId, clientId, clientScopeId, updates.clientRolesToAdd, updates.realmRolesToAdd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = removeClientScopeMappings(ctx, keycloakClient, realmId, clientId, clientScopeId, updates.clientRolesToRemove, updates.realmRolesToRemove)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(clientScopeMappingsId(realmId, clientId, clientScopeId))
+
+	return append(diags, resourceKeycloakClientScopeMappingsRead(ctx, data, meta)...)
+}
+
+func resourceKeycloakClientScopeMappingsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	roleMappings, err := keycloakClient.GetRoleScopeMappings(ctx, realmId, clientId, clientScopeId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	var realmRoles []string
+	for _, realmRole := range roleMappings.RealmMappings {
+		realmRoles = append(realmRoles, realmRole.Name)
+	}
+
+	var clientRoles []interface{}
+	for _, clientRoleMapping := range roleMappings.ClientMappings {
+		var roleNames []string
+		for _, clientRole := range clientRoleMapping.Mappings {
+			roleNames = append(roleNames, clientRole.Name)
+		}
+
+		clientRoles = append(clientRoles, map[string]interface{}{
+			"client_id": clientRoleMapping.Id,
+			"roles":     roleNames,
+		})
+	}
+
+	data.Set("realm_roles", realmRoles)
+	data.Set("client_roles", clientRoles)
+	data.SetId(clientScopeMappingsId(realmId, clientId, clientScopeId))
+
+	return nil
+}
+
+func resourceKeycloakClientScopeMappingsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	rolesToRemove, err := getClientScopeMappingsFromData(ctx, keycloakClient, data, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = removeClientScopeMappings(ctx, keycloakClient, realmId, clientId, clientScopeId, rolesToRemove.clientRoles, rolesToRemove.realmRoles)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakClientScopeMappingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/client/{{clientId}}, {{realmId}}/client-scope/{{clientScopeId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+
+	switch parts[1] {
+	case "client":
+		d.Set("client_id", parts[2])
+	case "client-scope":
+		d.Set("client_scope_id", parts[2])
+	default:
+		return nil, fmt.Errorf("the associated parent resource must be either a client or a client-scope")
+	}
+
+	diagnostics := resourceKeycloakClientScopeMappingsRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakClientScopeMappings_basic(t *testing.T) {
+	t.Parallel()
+
+	clientName := acctest.RandomWithPrefix("tf-acc")
+	parentClientName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_client_scope_mappings.mappings"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientScopeMappings_basic(clientName, parentClientName, realmRoleName, clientRoleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientScopeMappingsCount("keycloak_openid_client.client", 1, 1),
+					resource.TestCheckResourceAttr(resourceName, "realm_roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_roles.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakClientScopeMappings_realmRolesOnly(clientName, parentClientName, realmRoleName, clientRoleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientScopeMappingsCount("keycloak_openid_client.client", 1, 0),
+					resource.TestCheckResourceAttr(resourceName, "client_roles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientScopeMappings_removesUnmanagedMappings(t *testing.T) {
+	t.Parallel()
+
+	clientName := acctest.RandomWithPrefix("tf-acc")
+	parentClientName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	var client = &keycloak.GenericClient{}
+	var clientRole = &keycloak.Role{}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientScopeMappings_realmRolesOnly(clientName, parentClientName, realmRoleName, clientRoleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakGenericClientFetch("keycloak_openid_client.client", client),
+					testAccCheckKeycloakRoleFetch("keycloak_role.client_role", clientRole),
+				),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.CreateRoleScopeMapping(testCtx, client.RealmId, client.Id, "", clientRole)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakClientScopeMappings_realmRolesOnly(clientName, parentClientName, realmRoleName, clientRoleName),
+				Check:  testAccCheckKeycloakClientScopeMappingsCount("keycloak_openid_client.client", 1, 0),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakClientScopeMappingsCount(resourceName string, realmRoles, clientRoles int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+
+		roleMappings, err := keycloakClient.GetRoleScopeMappings(testCtx, realmId, rs.Primary.ID, "")
+		if err != nil {
+			return err
+		}
+
+		if len(roleMappings.RealmMappings) != realmRoles {
+			return fmt.Errorf("expected %d realm role scope mappings, got %d", realmRoles, len(roleMappings.RealmMappings))
+		}
+
+		var clientRoleCount int
+		for _, clientRoleMapping := range roleMappings.ClientMappings {
+			clientRoleCount += len(clientRoleMapping.Mappings)
+		}
+
+		if clientRoleCount != clientRoles {
+			return fmt.Errorf("expected %d client role scope mappings, got %d", clientRoles, clientRoleCount)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakClientScopeMappings_basic(clientName, parentClientName, realmRoleName, clientRoleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id           = data.keycloak_realm.realm.id
+	client_id          = "%s"
+	access_type        = "PUBLIC"
+	full_scope_allowed = false
+}
+
+resource "keycloak_openid_client" "parent_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_role" "realm_role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.parent_client.id
+	name      = "%s"
+}
+
+resource "keycloak_client_scope_mappings" "mappings" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+
+	realm_roles = [
+		keycloak_role.realm_role.name,
+	]
+
+	client_roles {
+		client_id = keycloak_openid_client.parent_client.id
+		roles     = [
+			keycloak_role.client_role.name,
+		]
+	}
+}
+	`, testAccRealm.Realm, clientName, parentClientName, realmRoleName, clientRoleName)
+}
+
+func testKeycloakClientScopeMappings_realmRolesOnly(clientName, parentClientName, realmRoleName, clientRoleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id           = data.keycloak_realm.realm.id
+	client_id          = "%s"
+	access_type        = "PUBLIC"
+	full_scope_allowed = false
+}
+
+resource "keycloak_openid_client" "parent_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_role" "realm_role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.parent_client.id
+	name      = "%s"
+}
+
+resource "keycloak_client_scope_mappings" "mappings" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+
+	realm_roles = [
+		keycloak_role.realm_role.name,
+	]
+}
+	`, testAccRealm.Realm, clientName, parentClientName, realmRoleName, clientRoleName)
+}
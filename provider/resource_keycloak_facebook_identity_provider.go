@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakFacebookIdentityProvider() *schema.Resource {
+	configAttributes := map[string]socialIdentityProviderConfigAttribute{
+		"fetched_fields": {
+			configKey: "fetchedFields",
+			schema: &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A comma separated list of additional fields to fetch from the Facebook profile of the user.",
+			},
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("facebook", "Facebook", configAttributes)
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakGithubIdentityProvider() *schema.Resource {
+	configAttributes := map[string]socialIdentityProviderConfigAttribute{
+		"base_url": {
+			configKey: "baseUrl",
+			schema: &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The base url of GitHub, only needed for GitHub Enterprise. Defaults to https://github.com.",
+			},
+		},
+		"api_url": {
+			configKey: "apiUrl",
+			schema: &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The url of the GitHub API, only needed for GitHub Enterprise. Defaults to https://api.github.com.",
+			},
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("github", "GitHub", configAttributes)
+}
@@ -17,7 +17,8 @@ func resourceKeycloakGroup() *schema.Resource {
 		ReadContext:   resourceKeycloakGroupRead,
 		DeleteContext: resourceKeycloakGroupDelete,
 		UpdateContext: resourceKeycloakGroupUpdate,
-		// This resource can be imported using {{realm}}/{{group_id}}. The Group ID is displayed in the URL when editing it from the GUI
+		// This resource can be imported using {{realm}}/{{group_id}}. The Group ID is displayed in the URL when editing it from the GUI.
+		// It can also be imported using {{realm}}/{{group_path}}, such as my-realm//parent/child
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakGroupImport,
 		},
@@ -140,18 +141,26 @@ func resourceKeycloakGroupDelete(ctx context.Context, data *schema.ResourceData,
 func resourceKeycloakGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{groupId}}")
+	// the group can either be referenced by its id, or by its path, which starts with a slash
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[1] == "" || (!strings.HasPrefix(parts[1], "/") && strings.Contains(parts[1], "/")) {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{groupId}}, {{realmId}}/{{groupPath}}")
 	}
 
-	_, err := keycloakClient.GetGroup(ctx, parts[0], parts[1])
+	var group *keycloak.Group
+	var err error
+
+	if strings.HasPrefix(parts[1], "/") {
+		group, err = keycloakClient.GetGroupByPath(ctx, parts[0], parts[1])
+	} else {
+		group, err = keycloakClient.GetGroup(ctx, parts[0], parts[1])
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	d.Set("realm_id", parts[0])
-	d.SetId(parts[1])
+	d.SetId(group.Id)
 
 	diagnostics := resourceKeycloakGroupRead(ctx, d, meta)
 	if diagnostics.HasError() {
@@ -63,6 +63,10 @@ func resourceKeycloakGroupPermissionsUpdate(ctx context.Context, data *schema.Re
 	realmId := data.Get("realm_id").(string)
 	groupId := data.Get("group_id").(string)
 
+	if err := checkAdminPermissionsV1(ctx, keycloakClient, realmId); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// the existence of this resource implies that it is enabled.
 	err := keycloakClient.EnableGroupPermissions(ctx, realmId, groupId)
 	if err != nil {
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -165,6 +166,44 @@ func TestAccKeycloakGroup_nestedGroupNameContainsBackSlash(t *testing.T) {
 	runTestNestedGroup(t, parentGroupName, firstChildGroupName, secondChildGroupName)
 }
 
+func TestAccKeycloakGroup_importByPath(t *testing.T) {
+	t.Parallel()
+
+	parentGroupName := acctest.RandomWithPrefix("tf-acc")
+	firstChildGroupName := acctest.RandomWithPrefix("tf-acc")
+	secondChildGroupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroup_nested(parentGroupName, firstChildGroupName, secondChildGroupName, "keycloak_group.first_child_group"),
+				Check:  testAccCheckKeycloakGroupExists("keycloak_group.second_child_group"),
+			},
+			{
+				ResourceName:      "keycloak_group.parent_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s//%s", testAccRealm.Realm, parentGroupName),
+			},
+			{
+				ResourceName:      "keycloak_group.second_child_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s//%s/%s/%s", testAccRealm.Realm, parentGroupName, firstChildGroupName, secondChildGroupName),
+			},
+			{
+				ResourceName:  "keycloak_group.second_child_group",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s//%s/%s", testAccRealm.Realm, parentGroupName, secondChildGroupName),
+				ExpectError:   regexp.MustCompile("no group with path"),
+			},
+		},
+	})
+}
+
 func runTestNestedGroup(t *testing.T, parentGroupName, firstChildGroupName, secondChildGroupName string) {
 	parentGroupResource := "keycloak_group.parent_group"
 	firstChildGroupResource := "keycloak_group.first_child_group"
@@ -27,6 +27,12 @@ func TestAccKeycloakHardcodedAttributeIdentityProviderMapper_basic(t *testing.T)
 				Config: testKeycloakHardcodedAttributeIdentityProviderMapper_basic(alias, mapperName, attributeName, attributeValue, userSession),
 				Check:  testAccCheckKeycloakHardcodedAttributeIdentityProviderMapperExists("keycloak_hardcoded_attribute_identity_provider_mapper.oidc"),
 			},
+			{
+				ResourceName:      "keycloak_hardcoded_attribute_identity_provider_mapper.oidc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId("keycloak_hardcoded_attribute_identity_provider_mapper.oidc"),
+			},
 		},
 	})
 }
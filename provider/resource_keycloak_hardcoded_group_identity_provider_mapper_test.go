@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakHardcodedGroupIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_hardcoded_group_identity_provider_mapper.oidc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_hardcoded_group_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakHardcodedGroupIdentityProviderMapper_basic(alias, groupName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperHasType(resourceName, "oidc-hardcoded-group-idp-mapper"),
+					testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "group", fmt.Sprintf("/%s-first", groupName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId(resourceName),
+			},
+			{
+				Config: testKeycloakHardcodedGroupIdentityProviderMapper_basic(alias, groupName, "second"),
+				Check:  testAccCheckKeycloakIdentityProviderMapperHasConfigValue(resourceName, "group", fmt.Sprintf("/%s-second", groupName)),
+			},
+		},
+	})
+}
+
+func testKeycloakHardcodedGroupIdentityProviderMapper_basic(alias, groupName, mappedGroup string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_group" "first" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-first"
+}
+
+resource "keycloak_group" "second" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-second"
+}
+
+resource "keycloak_hardcoded_group_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "hardcoded-group"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	group                   = keycloak_group.%s.path
+}
+	`, testAccRealm.Realm, alias, groupName, groupName, mappedGroup)
+}
@@ -19,6 +19,7 @@ func resourceKeycloakHardcodedRoleIdentityProviderMapper() *schema.Resource {
 	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getHardcodedRoleIdentityProviderMapperFromData, setHardcodedRoleIdentityProviderMapperData)
 	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setHardcodedRoleIdentityProviderMapperData)
 	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getHardcodedRoleIdentityProviderMapperFromData, setHardcodedRoleIdentityProviderMapperData)
+	genericMapperResource.Importer.StateContext = resourceKeycloakIdentityProviderMapperTypedImport(getHardcodedRoleIdentityProviderMapperFromData, setHardcodedRoleIdentityProviderMapperData)
 	return genericMapperResource
 }
 
@@ -26,6 +26,12 @@ func TestAccKeycloakHardcodedRoleIdentityProviderMapper_basic(t *testing.T) {
 				Config: testKeycloakHardcodedRoleIdentityProviderMapper_basic(alias, mapperName, role),
 				Check:  testAccCheckKeycloakHardcodedRoleIdentityProviderMapperExists("keycloak_hardcoded_role_identity_provider_mapper.oidc"),
 			},
+			{
+				ResourceName:      "keycloak_hardcoded_role_identity_provider_mapper.oidc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId("keycloak_hardcoded_role_identity_provider_mapper.oidc"),
+			},
 		},
 	})
 }
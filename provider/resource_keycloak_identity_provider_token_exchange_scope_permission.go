@@ -198,6 +198,10 @@ func resourceKeycloakIdentityProviderTokenExchangeScopePermissionUpdate(ctx cont
 		}
 	}
 
+	if err := checkAdminPermissionsV1(ctx, keycloakClient, realmId); err != nil {
+		return diag.FromErr(err)
+	}
+
 	err := keycloakClient.EnableIdentityProviderPermissions(ctx, realmId, providerAlias)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"dario.cat/mergo"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakJwtAuthorizationGrantIdentityProvider() *schema.Resource {
+	jwtAuthorizationGrantSchema := map[string]*schema.Schema{
+		"provider_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "jwt-authorization-grant",
+			Description: "provider id, is always jwt-authorization-grant, unless you have a extended custom implementation",
+		},
+		"issuer": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The issuer of the assertions, which has to match the iss claim of every assertion.",
+		},
+		"jwks_url": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The url of the JSON web key set that is used to validate the signature of the assertions.",
+		},
+		"allowed_clock_skew": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The clock skew in seconds that is tolerated when validating assertions.",
+		},
+		"max_allowed_assertion_expiration": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum time in seconds an assertion may be valid for. Assertions with a longer expiration are rejected.",
+		},
+		"assertion_signature_algorithm": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The algorithm assertions have to be signed with. When empty, any algorithm supported by Keycloak is accepted.",
+		},
+		"assertion_reuse_allowed": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When true, the same assertion can be exchanged more than once.",
+		},
+		"hide_on_login_page": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Hide On Login Page.",
+		},
+	}
+
+	jwtAuthorizationGrantResource := resourceKeycloakIdentityProvider()
+	jwtAuthorizationGrantResource.Schema = mergeSchemas(jwtAuthorizationGrantResource.Schema, jwtAuthorizationGrantSchema)
+	jwtAuthorizationGrantResource.CreateContext = resourceKeycloakJwtAuthorizationGrantIdentityProviderCreate
+	jwtAuthorizationGrantResource.ReadContext = resourceKeycloakIdentityProviderRead(setJwtAuthorizationGrantIdentityProviderData)
+	jwtAuthorizationGrantResource.UpdateContext = resourceKeycloakIdentityProviderUpdate(getJwtAuthorizationGrantIdentityProviderFromData, setJwtAuthorizationGrantIdentityProviderData)
+
+	return jwtAuthorizationGrantResource
+}
+
+func resourceKeycloakJwtAuthorizationGrantIdentityProviderCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_26_5); !ok && err == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "this resource requires Keycloak v26.5 or higher",
+		}}
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakIdentityProviderCreate(getJwtAuthorizationGrantIdentityProviderFromData, setJwtAuthorizationGrantIdentityProviderData)(ctx, data, meta)
+}
+
+func getJwtAuthorizationGrantIdentityProviderFromData(data *schema.ResourceData, keycloakVersion *version.Version) (*keycloak.IdentityProvider, error) {
+	rec, defaultConfig := getIdentityProviderFromData(data, keycloakVersion)
+	rec.ProviderId = data.Get("provider_id").(string)
+
+	jwtAuthorizationGrantIdentityProviderConfig := &keycloak.IdentityProviderConfig{
+		Issuer:            data.Get("issuer").(string),
+		JwksUrl:           data.Get("jwks_url").(string),
+		UseJwksUrl:        true,
+		ValidateSignature: true,
+	}
+
+	if err := mergo.Merge(jwtAuthorizationGrantIdentityProviderConfig, defaultConfig); err != nil {
+		return nil, err
+	}
+
+	if jwtAuthorizationGrantIdentityProviderConfig.ExtraConfig == nil {
+		jwtAuthorizationGrantIdentityProviderConfig.ExtraConfig = map[string]interface{}{}
+	}
+	jwtAuthorizationGrantIdentityProviderConfig.ExtraConfig["jwtAuthorizationGrantEnabled"] = "true"
+	jwtAuthorizationGrantIdentityProviderConfig.ExtraConfig["jwtAuthorizationGrantAllowedClockSkew"] = strconv.Itoa(data.Get("allowed_clock_skew").(int))
+	jwtAuthorizationGrantIdentityProviderConfig.ExtraConfig["jwtAuthorizationGrantMaxAllowedAssertionExpiration"] = strconv.Itoa(data.Get("max_allowed_assertion_expiration").(int))
+	jwtAuthorizationGrantIdentityProviderConfig.ExtraConfig["jwtAuthorizationGrantAssertionSignatureAlg"] = data.Get("assertion_signature_algorithm").(string)
+	jwtAuthorizationGrantIdentityProviderConfig.ExtraConfig["jwtAuthorizationGrantAssertionReuseAllowed"] = strconv.FormatBool(data.Get("assertion_reuse_allowed").(bool))
+
+	rec.Config = jwtAuthorizationGrantIdentityProviderConfig
+
+	return rec, nil
+}
+
+func setJwtAuthorizationGrantIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, keycloakVersion *version.Version) error {
+	setIdentityProviderData(data, identityProvider, keycloakVersion)
+	data.Set("provider_id", identityProvider.ProviderId)
+	data.Set("issuer", identityProvider.Config.Issuer)
+	data.Set("jwks_url", identityProvider.Config.JwksUrl)
+
+	extraConfig := identityProvider.Config.ExtraConfig
+
+	if allowedClockSkew, ok := extraConfig["jwtAuthorizationGrantAllowedClockSkew"].(string); ok {
+		allowedClockSkewInt, _ := strconv.Atoi(allowedClockSkew)
+		data.Set("allowed_clock_skew", allowedClockSkewInt)
+	}
+	if maxAllowedAssertionExpiration, ok := extraConfig["jwtAuthorizationGrantMaxAllowedAssertionExpiration"].(string); ok {
+		maxAllowedAssertionExpirationInt, _ := strconv.Atoi(maxAllowedAssertionExpiration)
+		data.Set("max_allowed_assertion_expiration", maxAllowedAssertionExpirationInt)
+	}
+	if assertionSignatureAlgorithm, ok := extraConfig["jwtAuthorizationGrantAssertionSignatureAlg"].(string); ok {
+		data.Set("assertion_signature_algorithm", assertionSignatureAlgorithm)
+	}
+	if assertionReuseAllowed, ok := extraConfig["jwtAuthorizationGrantAssertionReuseAllowed"].(string); ok {
+		assertionReuseAllowedBool, _ := strconv.ParseBool(assertionReuseAllowed)
+		data.Set("assertion_reuse_allowed", assertionReuseAllowedBool)
+	}
+
+	return nil
+}
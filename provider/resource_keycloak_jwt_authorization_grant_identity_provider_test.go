@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakJwtAuthorizationGrantIdentityProvider_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_5)
+
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_jwt_authorization_grant_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakJwtAuthorizationGrantIdentityProvider_basic(alias, "https://issuer.example.com", 10, 300, "RS256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderExists("keycloak_jwt_authorization_grant_identity_provider.jwt", "jwt-authorization-grant"),
+					resource.TestCheckResourceAttr("keycloak_jwt_authorization_grant_identity_provider.jwt", "issuer", "https://issuer.example.com"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_jwt_authorization_grant_identity_provider.jwt", "jwtAuthorizationGrantEnabled", "true"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_jwt_authorization_grant_identity_provider.jwt", "jwtAuthorizationGrantAllowedClockSkew", "10"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_jwt_authorization_grant_identity_provider.jwt", "jwtAuthorizationGrantMaxAllowedAssertionExpiration", "300"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_jwt_authorization_grant_identity_provider.jwt", "jwtAuthorizationGrantAssertionSignatureAlg", "RS256"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_jwt_authorization_grant_identity_provider.jwt",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
+			{
+				Config: testKeycloakJwtAuthorizationGrantIdentityProvider_basic(alias, "https://issuer2.example.com", 0, 60, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_jwt_authorization_grant_identity_provider.jwt", "issuer", "https://issuer2.example.com"),
+					resource.TestCheckResourceAttr("keycloak_jwt_authorization_grant_identity_provider.jwt", "jwks_url", "https://issuer2.example.com/jwks"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_jwt_authorization_grant_identity_provider.jwt", "jwtAuthorizationGrantAllowedClockSkew", "0"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_jwt_authorization_grant_identity_provider.jwt", "jwtAuthorizationGrantMaxAllowedAssertionExpiration", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakJwtAuthorizationGrantIdentityProvider_basic(alias, issuer string, allowedClockSkew, maxAllowedAssertionExpiration int, assertionSignatureAlgorithm string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_jwt_authorization_grant_identity_provider" "jwt" {
+	realm                            = data.keycloak_realm.realm.id
+	alias                            = "%s"
+	issuer                           = "%s"
+	jwks_url                         = "%s/jwks"
+	allowed_clock_skew               = %d
+	max_allowed_assertion_expiration = %d
+	assertion_signature_algorithm    = "%s"
+}
+	`, testAccRealm.Realm, alias, issuer, issuer, allowedClockSkew, maxAllowedAssertionExpiration, assertionSignatureAlgorithm)
+}
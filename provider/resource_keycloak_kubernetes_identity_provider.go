@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"dario.cat/mergo"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakKubernetesIdentityProvider() *schema.Resource {
+	kubernetesSchema := map[string]*schema.Schema{
+		"provider_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "kubernetes",
+			Description: "provider id, is always kubernetes, unless you have a extended custom implementation",
+		},
+		"issuer": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The issuer of the Kubernetes service account tokens. The signing keys are retrieved from the Kubernetes API server.",
+		},
+		"allowed_clock_skew": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The clock skew in seconds that is tolerated when validating service account tokens.",
+		},
+		"hide_on_login_page": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Hide On Login Page.",
+		},
+	}
+
+	kubernetesResource := resourceKeycloakIdentityProvider()
+	kubernetesResource.Schema = mergeSchemas(kubernetesResource.Schema, kubernetesSchema)
+	kubernetesResource.CreateContext = resourceKeycloakKubernetesIdentityProviderCreate
+	kubernetesResource.ReadContext = resourceKeycloakIdentityProviderRead(setKubernetesIdentityProviderData)
+	kubernetesResource.UpdateContext = resourceKeycloakIdentityProviderUpdate(getKubernetesIdentityProviderFromData, setKubernetesIdentityProviderData)
+
+	return kubernetesResource
+}
+
+func resourceKeycloakKubernetesIdentityProviderCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_26_4); !ok && err == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "this resource requires Keycloak v26.4 or higher",
+		}}
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakIdentityProviderCreate(getKubernetesIdentityProviderFromData, setKubernetesIdentityProviderData)(ctx, data, meta)
+}
+
+func getKubernetesIdentityProviderFromData(data *schema.ResourceData, keycloakVersion *version.Version) (*keycloak.IdentityProvider, error) {
+	rec, defaultConfig := getIdentityProviderFromData(data, keycloakVersion)
+	rec.ProviderId = data.Get("provider_id").(string)
+
+	kubernetesIdentityProviderConfig := &keycloak.IdentityProviderConfig{
+		Issuer: data.Get("issuer").(string),
+	}
+
+	if err := mergo.Merge(kubernetesIdentityProviderConfig, defaultConfig); err != nil {
+		return nil, err
+	}
+
+	if kubernetesIdentityProviderConfig.ExtraConfig == nil {
+		kubernetesIdentityProviderConfig.ExtraConfig = map[string]interface{}{}
+	}
+	kubernetesIdentityProviderConfig.ExtraConfig["allowedClockSkew"] = strconv.Itoa(data.Get("allowed_clock_skew").(int))
+
+	rec.Config = kubernetesIdentityProviderConfig
+
+	return rec, nil
+}
+
+func setKubernetesIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, keycloakVersion *version.Version) error {
+	setIdentityProviderData(data, identityProvider, keycloakVersion)
+	data.Set("provider_id", identityProvider.ProviderId)
+	data.Set("issuer", identityProvider.Config.Issuer)
+
+	if allowedClockSkew, ok := identityProvider.Config.ExtraConfig["allowedClockSkew"].(string); ok {
+		allowedClockSkewInt, _ := strconv.Atoi(allowedClockSkew)
+		data.Set("allowed_clock_skew", allowedClockSkewInt)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakKubernetesIdentityProvider_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_4)
+
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_kubernetes_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakKubernetesIdentityProvider_basic(alias, "https://kubernetes.default.svc.cluster.local", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderExists("keycloak_kubernetes_identity_provider.kubernetes", "kubernetes"),
+					resource.TestCheckResourceAttr("keycloak_kubernetes_identity_provider.kubernetes", "issuer", "https://kubernetes.default.svc.cluster.local"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_kubernetes_identity_provider.kubernetes", "allowedClockSkew", "0"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_kubernetes_identity_provider.kubernetes",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
+			{
+				Config: testKeycloakKubernetesIdentityProvider_basic(alias, "https://oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_kubernetes_identity_provider.kubernetes", "issuer", "https://oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_kubernetes_identity_provider.kubernetes", "allowedClockSkew", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakKubernetesIdentityProvider_basic(alias, issuer string, allowedClockSkew int) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_kubernetes_identity_provider" "kubernetes" {
+	realm              = data.keycloak_realm.realm.id
+	alias              = "%s"
+	issuer             = "%s"
+	allowed_clock_skew = %d
+}
+	`, testAccRealm.Realm, alias, issuer, allowedClockSkew)
+}
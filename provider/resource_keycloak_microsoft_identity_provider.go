@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakMicrosoftIdentityProvider() *schema.Resource {
+	configAttributes := map[string]socialIdentityProviderConfigAttribute{
+		"tenant_id": {
+			configKey: "tenantId",
+			schema: &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Microsoft Entra ID tenant users are allowed to log in from. When empty, users of any tenant and personal Microsoft accounts are allowed to log in.",
+			},
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("microsoft", "Microsoft", configAttributes)
+}
@@ -27,6 +27,13 @@ func TestAccKeycloakOidcGoogleIdentityProvider_basic(t *testing.T) {
 				Config: testKeycloakOidcGoogleIdentityProvider_basic(),
 				Check:  testAccCheckKeycloakOidcGoogleIdentityProviderExists("keycloak_oidc_google_identity_provider.google"),
 			},
+			{
+				ResourceName:            "keycloak_oidc_google_identity_provider.google",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
 		},
 	})
 }
@@ -157,6 +157,7 @@ func getOidcIdentityProviderFromData(data *schema.ResourceData, keycloakVersion
 
 func setOidcIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, keycloakVersion *version.Version) error {
 	setIdentityProviderData(data, identityProvider, keycloakVersion)
+	data.Set("provider_id", identityProvider.ProviderId)
 	data.Set("backchannel_supported", identityProvider.Config.BackchannelSupported)
 	data.Set("jwks_url", identityProvider.Config.JwksUrl)
 	data.Set("logout_url", identityProvider.Config.LogoutUrl)
@@ -169,6 +170,8 @@ func setOidcIdentityProviderData(data *schema.ResourceData, identityProvider *ke
 	data.Set("login_hint", identityProvider.Config.LoginHint)
 	data.Set("ui_locales", identityProvider.Config.UILocales)
 	data.Set("issuer", identityProvider.Config.Issuer)
+	data.Set("default_scopes", identityProvider.Config.DefaultScope)
+	data.Set("accepts_prompt_none_forward_from_client", identityProvider.Config.AcceptsPromptNoneForwFrmClt)
 
 	if keycloakVersion.LessThan(keycloak.Version_26.AsVersion()) {
 		// Since keycloak v26 the attribute "hideOnLoginPage" is not part of the identity provider config anymore!
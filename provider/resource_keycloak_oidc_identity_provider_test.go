@@ -26,6 +26,13 @@ func TestAccKeycloakOidcIdentityProvider_basic(t *testing.T) {
 				Config: testKeycloakOidcIdentityProvider_basic(oidcName),
 				Check:  testAccCheckKeycloakOidcIdentityProviderExists("keycloak_oidc_identity_provider.oidc"),
 			},
+			{
+				ResourceName:            "keycloak_oidc_identity_provider.oidc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
 		},
 	})
 }
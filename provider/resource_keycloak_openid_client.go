@@ -299,6 +299,28 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"jwt_credential_issuer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The alias of the identity provider that issues the tokens this client authenticates with, when client_authenticator_type is federated-jwt.",
+			},
+			"jwt_credential_subject": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The subject of the tokens this client authenticates with, when client_authenticator_type is federated-jwt.",
+			},
+			"oauth2_jwt_authorization_grant_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"oauth2_jwt_authorization_grant_identity_providers": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "The aliases of the identity providers whose assertions this client may exchange using the JWT authorization grant.",
+			},
 			"always_display_in_console": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -385,6 +407,10 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 			ConsentScreenText:                     data.Get("consent_screen_text").(string),
 			DisplayOnConsentScreen:                types.KeycloakBoolQuoted(data.Get("display_on_consent_screen").(bool)),
 			PostLogoutRedirectUris:                types.KeycloakSliceHashDelimited(validPostLogoutRedirectUris),
+			JwtCredentialIssuer:                   data.Get("jwt_credential_issuer").(string),
+			JwtCredentialSubject:                  data.Get("jwt_credential_subject").(string),
+			Oauth2JwtAuthorizationGrantEnabled:    types.KeycloakBoolQuoted(data.Get("oauth2_jwt_authorization_grant_enabled").(bool)),
+			Oauth2JwtAuthorizationGrantIdps:       types.KeycloakSliceHashDelimited(interfaceSliceToStringSlice(data.Get("oauth2_jwt_authorization_grant_identity_providers").(*schema.Set).List())),
 		},
 		ValidRedirectUris:      validRedirectUris,
 		WebOrigins:             webOrigins,
@@ -443,6 +469,16 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 	return openidClient, nil
 }
 
+func getOpenidClientAccessType(client *keycloak.OpenidClient) string {
+	if client.PublicClient {
+		return "PUBLIC"
+	} else if client.BearerOnly {
+		return "BEARER-ONLY"
+	}
+
+	return "CONFIDENTIAL"
+}
+
 func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
 	var serviceAccountUserId string
 	if client.ServiceAccountsEnabled {
@@ -482,6 +518,10 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 	data.Set("oauth2_device_authorization_grant_enabled", client.Attributes.Oauth2DeviceAuthorizationGrantEnabled)
 	data.Set("oauth2_device_code_lifespan", client.Attributes.Oauth2DeviceCodeLifespan)
 	data.Set("oauth2_device_polling_interval", client.Attributes.Oauth2DevicePollingInterval)
+	data.Set("jwt_credential_issuer", client.Attributes.JwtCredentialIssuer)
+	data.Set("jwt_credential_subject", client.Attributes.JwtCredentialSubject)
+	data.Set("oauth2_jwt_authorization_grant_enabled", client.Attributes.Oauth2JwtAuthorizationGrantEnabled)
+	data.Set("oauth2_jwt_authorization_grant_identity_providers", client.Attributes.Oauth2JwtAuthorizationGrantIdps)
 	data.Set("client_offline_session_idle_timeout", client.Attributes.ClientOfflineSessionIdleTimeout)
 	data.Set("client_offline_session_max_lifespan", client.Attributes.ClientOfflineSessionMaxLifespan)
 	data.Set("client_session_idle_timeout", client.Attributes.ClientSessionIdleTimeout)
@@ -504,14 +544,7 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 		data.Set("service_account_user_id", "")
 	}
 
-	// access type
-	if client.PublicClient {
-		data.Set("access_type", "PUBLIC")
-	} else if client.BearerOnly {
-		data.Set("access_type", "BEARER-ONLY")
-	} else {
-		data.Set("access_type", "CONFIDENTIAL")
-	}
+	data.Set("access_type", getOpenidClientAccessType(client))
 
 	if (keycloak.OpenidAuthenticationFlowBindingOverrides{}) == client.AuthenticationFlowBindingOverrides {
 		data.Set("authentication_flow_binding_overrides", nil)
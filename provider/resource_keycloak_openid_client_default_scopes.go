@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +17,10 @@ func resourceKeycloakOpenidClientDefaultScopes() *schema.Resource {
 		ReadContext:   resourceKeycloakOpenidClientDefaultScopesRead,
 		DeleteContext: resourceKeycloakOpenidClientDefaultScopesDelete,
 		UpdateContext: resourceKeycloakOpenidClientDefaultScopesReconcile,
+		// This resource can be imported using {{realm}}/{{client_id}}, where client_id is the unique ID of the client
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOpenidClientDefaultScopesImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -115,3 +121,30 @@ func resourceKeycloakOpenidClientDefaultScopesDelete(ctx context.Context, data *
 
 	return diag.FromErr(keycloakClient.DetachOpenidClientDefaultScopes(ctx, realmId, clientId, interfaceSliceToStringSlice(defaultScopes.List())))
 }
+
+func resourceKeycloakOpenidClientDefaultScopesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{openidClientId}}")
+	}
+
+	realmId := parts[0]
+	clientId := parts[1]
+
+	if _, err := keycloakClient.GetOpenidClient(ctx, realmId, clientId); err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("client_id", clientId)
+	d.SetId(openidClientDefaultScopesId(realmId, clientId))
+
+	diagnostics := resourceKeycloakOpenidClientDefaultScopesRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
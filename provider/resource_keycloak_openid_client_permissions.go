@@ -62,6 +62,10 @@ func resourceKeycloakOpenidClientPermissionsReconcile(ctx context.Context, data
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 
+	if err := checkAdminPermissionsV1(ctx, keycloakClient, realmId); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// the existence of this resource implies that permissions are enabled for this client.
 	err := keycloakClient.EnableOpenidClientPermissions(ctx, realmId, clientId)
 	if err != nil {
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakOpenidClientScopeAttachmentTypes = []string{"default", "optional"}
+
+func resourceKeycloakOpenidClientScopeAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenidClientScopeAttachmentCreate,
+		ReadContext:   resourceKeycloakOpenidClientScopeAttachmentRead,
+		DeleteContext: resourceKeycloakOpenidClientScopeAttachmentDelete,
+		// This resource can be imported using {{realm}}/{{client_id}}/{{client_scope_id}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOpenidClientScopeAttachmentImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientScopeAttachmentTypes, false),
+			},
+		},
+	}
+}
+
+func openidClientScopeAttachmentId(realmId, clientId, clientScopeId string) string {
+	return fmt.Sprintf("%s/%s/%s", realmId, clientId, clientScopeId)
+}
+
+// returns the type of the attachment ("default" or "optional"), or an empty string if the scope is not attached to the client
+func getOpenidClientScopeAttachmentType(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId, clientScopeId string) (string, error) {
+	defaultClientScopes, err := keycloakClient.GetOpenidClientDefaultScopes(ctx, realmId, clientId)
+	if err != nil {
+		return "", err
+	}
+
+	for _, clientScope := range defaultClientScopes {
+		if clientScope.Id == clientScopeId {
+			return "default", nil
+		}
+	}
+
+	optionalClientScopes, err := keycloakClient.GetOpenidClientOptionalScopes(ctx, realmId, clientId)
+	if err != nil {
+		return "", err
+	}
+
+	for _, clientScope := range optionalClientScopes {
+		if clientScope.Id == clientScopeId {
+			return "optional", nil
+		}
+	}
+
+	return "", nil
+}
+
+func resourceKeycloakOpenidClientScopeAttachmentCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	var err error
+	switch data.Get("type").(string) {
+	case "default":
+		err = keycloakClient.AttachOpenidClientDefaultScope(ctx, realmId, clientId, clientScopeId)
+	case "optional":
+		err = keycloakClient.AttachOpenidClientOptionalScope(ctx, realmId, clientId, clientScopeId)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(openidClientScopeAttachmentId(realmId, clientId, clientScopeId))
+
+	return resourceKeycloakOpenidClientScopeAttachmentRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenidClientScopeAttachmentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	attachmentType, err := getOpenidClientScopeAttachmentType(ctx, keycloakClient, realmId, clientId, clientScopeId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if attachmentType == "" {
+		tflog.Warn(ctx, "Removing resource from state as the client scope is no longer attached to the client", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+
+		return nil
+	}
+
+	data.Set("type", attachmentType)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientScopeAttachmentDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	var err error
+	switch data.Get("type").(string) {
+	case "default":
+		err = keycloakClient.DetachOpenidClientDefaultScope(ctx, realmId, clientId, clientScopeId)
+	case "optional":
+		err = keycloakClient.DetachOpenidClientOptionalScope(ctx, realmId, clientId, clientScopeId)
+	}
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientScopeAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{openidClientId}}/{{clientScopeId}}")
+	}
+
+	realmId := parts[0]
+	clientId := parts[1]
+	clientScopeId := parts[2]
+
+	attachmentType, err := getOpenidClientScopeAttachmentType(ctx, keycloakClient, realmId, clientId, clientScopeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if attachmentType == "" {
+		return nil, fmt.Errorf("client scope with id %s is not attached to client with id %s", clientScopeId, clientId)
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("client_id", clientId)
+	d.Set("client_scope_id", clientScopeId)
+	d.SetId(openidClientScopeAttachmentId(realmId, clientId, clientScopeId))
+
+	diagnostics := resourceKeycloakOpenidClientScopeAttachmentRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
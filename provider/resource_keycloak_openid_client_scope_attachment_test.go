@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakOpenidClientScopeAttachment_basic(t *testing.T) {
+	t.Parallel()
+	client := acctest.RandomWithPrefix("tf-acc")
+	clientScope := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientScopeAttachment_basic(client, clientScope, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					// the scopes that keycloak attaches to new clients must be left alone
+					testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client.client", append(preAssignedDefaultClientScopes, clientScope)),
+					resource.TestCheckResourceAttr("keycloak_openid_client_scope_attachment.attachment", "type", "default"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_openid_client_scope_attachment.attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakOpenidClientScopeAttachment_basic(client, clientScope, "optional"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientDefaultScopeIsNotAttached("keycloak_openid_client.client", clientScope),
+					testAccCheckKeycloakOpenidClientHasOptionalScopes("keycloak_openid_client.client", append(getPreAssignedOptionalClientScopes(), clientScope)),
+					testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client.client", preAssignedDefaultClientScopes),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientScopeAttachment_noAttachment(client, clientScope),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientOptionalScopeIsNotAttached("keycloak_openid_client.client", clientScope),
+					testAccCheckKeycloakOpenidClientHasOptionalScopes("keycloak_openid_client.client", getPreAssignedOptionalClientScopes()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientScopeAttachment_detachedOutsideOfTerraform(t *testing.T) {
+	t.Parallel()
+	client := acctest.RandomWithPrefix("tf-acc")
+	clientScope := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientScopeAttachment_basic(client, clientScope, "default"),
+				Check:  testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client.client", []string{clientScope}),
+			},
+			{
+				PreConfig: func() {
+					openidClient, err := keycloakClient.GetOpenidClientByClientId(testCtx, testAccRealm.Realm, client)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.DetachOpenidClientDefaultScopes(testCtx, testAccRealm.Realm, openidClient.Id, []string{clientScope})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakOpenidClientScopeAttachment_basic(client, clientScope, "default"),
+				Check:  testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client.client", []string{clientScope}),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientScopeAttachment_validateDuplicateScopeAssignment(t *testing.T) {
+	t.Parallel()
+	client := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClientScopeAttachment_duplicateScopeAssignment(client),
+				ExpectError: regexp.MustCompile("validation error: scope .+ is already attached to client as a default scope"),
+			},
+		},
+	})
+}
+
+func testKeycloakOpenidClientScopeAttachment_basic(client, clientScope, attachmentType string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_client_scope_attachment" "attachment" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id       = keycloak_openid_client.client.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+	type            = "%s"
+}
+	`, testAccRealm.Realm, client, clientScope, attachmentType)
+}
+
+func testKeycloakOpenidClientScopeAttachment_noAttachment(client, clientScope string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+	`, testAccRealm.Realm, client, clientScope)
+}
+
+func testKeycloakOpenidClientScopeAttachment_duplicateScopeAssignment(client string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_openid_client_scope" "profile" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "profile"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_openid_client_scope_attachment" "attachment" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id       = keycloak_openid_client.client.id
+	client_scope_id = data.keycloak_openid_client_scope.profile.id
+	type            = "optional"
+}
+	`, testAccRealm.Realm, client)
+}
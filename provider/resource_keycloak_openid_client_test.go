@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -821,6 +822,61 @@ func TestAccKeycloakOpenidClient_oauth2DeviceAuthorizationGrantEnabled(t *testin
 	})
 }
 
+func TestAccKeycloakOpenidClient_federatedJwt(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_4)
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_federatedJwt(clientId, alias, "system:serviceaccount:default:first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_authenticator_type", "federated-jwt"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "jwt_credential_issuer", alias),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "jwt_credential_subject", "system:serviceaccount:default:first"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_federatedJwt(clientId, alias, "system:serviceaccount:default:second"),
+				Check:  resource.TestCheckResourceAttr("keycloak_openid_client.client", "jwt_credential_subject", "system:serviceaccount:default:second"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_oauth2JwtAuthorizationGrantEnabled(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_5)
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_oauth2JwtAuthorizationGrantEnabled(clientId, alias, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientOauth2JwtAuthorizationGrant("keycloak_openid_client.client", true, []string{alias}),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "oauth2_jwt_authorization_grant_identity_providers.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_oauth2JwtAuthorizationGrantEnabled(clientId, alias, false),
+				Check:  testAccCheckKeycloakOpenidClientOauth2JwtAuthorizationGrant("keycloak_openid_client.client", false, []string{alias}),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getOpenidClientFromState(s, resourceName)
@@ -1241,6 +1297,25 @@ func testAccCheckKeycloakOpenidClientOauth2DeviceAuthorizationGrantEnabled(resou
 	}
 }
 
+func testAccCheckKeycloakOpenidClientOauth2JwtAuthorizationGrant(resourceName string, enabled bool, identityProviders []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if bool(client.Attributes.Oauth2JwtAuthorizationGrantEnabled) != enabled {
+			return fmt.Errorf("expected openid client to have JWT authorization grant enabled set to %t, but got %t", enabled, client.Attributes.Oauth2JwtAuthorizationGrantEnabled)
+		}
+
+		if !reflect.DeepEqual([]string(client.Attributes.Oauth2JwtAuthorizationGrantIdps), identityProviders) {
+			return fmt.Errorf("expected openid client to allow the JWT authorization grant for identity providers %v, but got %v", identityProviders, client.Attributes.Oauth2JwtAuthorizationGrantIdps)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOpenidClientExtraConfig(resourceName string, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getOpenidClientFromState(s, resourceName)
@@ -1823,6 +1898,53 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, oauth2DeviceAuthorizationGrantEnabled, oauth2DeviceCodeLifespan, oauth2DevicePollingInterval)
 }
 
+func testKeycloakOpenidClient_federatedJwt(clientId, alias, subject string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_kubernetes_identity_provider" "kubernetes" {
+	realm  = data.keycloak_realm.realm.id
+	alias  = "%s"
+	issuer = "https://kubernetes.default.svc.cluster.local"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                 = "%s"
+	realm_id                  = data.keycloak_realm.realm.id
+	access_type               = "CONFIDENTIAL"
+	service_accounts_enabled  = true
+	client_authenticator_type = "federated-jwt"
+	jwt_credential_issuer     = keycloak_kubernetes_identity_provider.kubernetes.alias
+	jwt_credential_subject    = "%s"
+}
+	`, testAccRealm.Realm, alias, clientId, subject)
+}
+
+func testKeycloakOpenidClient_oauth2JwtAuthorizationGrantEnabled(clientId, alias string, enabled bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_jwt_authorization_grant_identity_provider" "jwt" {
+	realm    = data.keycloak_realm.realm.id
+	alias    = "%s"
+	issuer   = "https://issuer.example.com"
+	jwks_url = "https://issuer.example.com/jwks"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                                         = "%s"
+	realm_id                                          = data.keycloak_realm.realm.id
+	access_type                                       = "CONFIDENTIAL"
+	oauth2_jwt_authorization_grant_enabled            = %t
+	oauth2_jwt_authorization_grant_identity_providers = [keycloak_jwt_authorization_grant_identity_provider.jwt.alias]
+}
+	`, testAccRealm.Realm, alias, clientId, enabled)
+}
+
 func testKeycloakOpenidClient_import(clientId string, enabled bool) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
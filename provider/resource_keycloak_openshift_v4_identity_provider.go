@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakOpenshiftV4IdentityProvider() *schema.Resource {
+	configAttributes := map[string]socialIdentityProviderConfigAttribute{
+		"base_url": {
+			configKey: "baseUrl",
+			schema: &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The url of the OpenShift 4 API server, such as https://api.cluster.example.com:6443.",
+			},
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("openshift-v4", "OpenShift 4", configAttributes)
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakPaypalIdentityProvider() *schema.Resource {
+	configAttributes := map[string]socialIdentityProviderConfigAttribute{
+		"sandbox": {
+			configKey: "sandbox",
+			schema: &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the PayPal sandbox is used instead of the live environment.",
+			},
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("paypal", "PayPal", configAttributes)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -186,6 +187,12 @@ func resourceKeycloakRealm() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"admin_permissions_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, fine-grained admin permissions (v2) are enabled for the realm. Requires Keycloak v26.2 or higher.",
+			},
 
 			// Login Config
 			"registration_allowed": {
@@ -785,6 +792,13 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 		DefaultLocale:               defaultLocale,
 	}
 
+	adminPermissionsEnabled := data.Get("admin_permissions_enabled").(bool)
+	if keycloakVersion.GreaterThanOrEqual(keycloak.Version_26_2.AsVersion()) {
+		realm.AdminPermissionsEnabled = &adminPermissionsEnabled
+	} else if adminPermissionsEnabled {
+		return nil, fmt.Errorf("admin_permissions_enabled requires Keycloak v26.2 or higher")
+	}
+
 	//smtp
 	if v, ok := data.GetOk("smtp_server"); ok {
 		smtpSettings := v.([]interface{})[0].(map[string]interface{})
@@ -1202,6 +1216,7 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 	data.Set("display_name_html", realm.DisplayNameHtml)
 	data.Set("user_managed_access", realm.UserManagedAccess)
 	data.Set("organizations_enabled", realm.OrganizationsEnabled)
+	data.Set("admin_permissions_enabled", realm.AdminPermissionsEnabled != nil && *realm.AdminPermissionsEnabled)
 
 	// Login Config
 	data.Set("registration_allowed", realm.RegistrationAllowed)
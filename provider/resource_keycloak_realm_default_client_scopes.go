@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"errors"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
@@ -13,6 +15,10 @@ func resourceKeycloakRealmDefaultClientScopes() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmDefaultClientScopesRead,
 		DeleteContext: resourceKeycloakRealmDefaultClientScopesDelete,
 		UpdateContext: resourceKeycloakRealmDefaultClientScopesReconcile,
+		// This resource can be imported using {{realm}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmDefaultClientScopesImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -97,3 +103,22 @@ func resourceKeycloakRealmDefaultClientScopesDelete(ctx context.Context, data *s
 
 	return diag.FromErr(keycloakClient.UnmarkClientScopesAsRealmDefault(ctx, realmId, interfaceSliceToStringSlice(defaultClientScopes.List())))
 }
+
+func resourceKeycloakRealmDefaultClientScopesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := d.Id()
+
+	if _, err := keycloakClient.GetRealm(ctx, realmId); err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+
+	diagnostics := resourceKeycloakRealmDefaultClientScopesRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
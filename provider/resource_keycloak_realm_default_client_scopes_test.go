@@ -26,6 +26,11 @@ func TestAccKeycloakRealmDefaultClientScopes_basic(t *testing.T) {
 					"keycloak_realm_default_client_scopes.default_scopes",
 					[]string{"profile", "email", "web-origins", "roles", "role_list", clientScope}),
 			},
+			{
+				ResourceName:      "keycloak_realm_default_client_scopes.default_scopes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -29,6 +29,11 @@ func TestAccKeycloakRealmOptionalClientScopes_basic(t *testing.T) {
 						[]string{"address", "phone", "offline_access", clientScope},
 					),
 				},
+				{
+					ResourceName:      "keycloak_realm_optional_client_scopes.optional_scopes",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
 			},
 		},
 	)
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRoleComposite() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRoleCompositeCreate,
+		ReadContext:   resourceKeycloakRoleCompositeRead,
+		DeleteContext: resourceKeycloakRoleCompositeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRoleCompositeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the composite role.",
+			},
+			"composite_role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the realm or client role that is added to the composite role.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakRoleCompositeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	roleId := data.Get("role_id").(string)
+	compositeRoleId := data.Get("composite_role_id").(string)
+
+	role, err := keycloakClient.GetRole(ctx, realmId, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	compositeRole, err := keycloakClient.GetRole(ctx, realmId, compositeRoleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = keycloakClient.ValidateRoleComposites(ctx, role, []*keycloak.Role{compositeRole}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = keycloakClient.AddCompositesToRole(ctx, role, []*keycloak.Role{compositeRole}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s/%s", realmId, roleId, compositeRoleId))
+
+	return resourceKeycloakRoleCompositeRead(ctx, data, meta)
+}
+
+func resourceKeycloakRoleCompositeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	roleId := data.Get("role_id").(string)
+	compositeRoleId := data.Get("composite_role_id").(string)
+
+	role, err := keycloakClient.GetRole(ctx, realmId, roleId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	composites, err := keycloakClient.GetRoleComposites(ctx, role)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, composite := range composites {
+		if composite.Id == compositeRoleId {
+			return nil
+		}
+	}
+
+	data.SetId("")
+
+	return nil
+}
+
+func resourceKeycloakRoleCompositeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	roleId := data.Get("role_id").(string)
+	compositeRoleId := data.Get("composite_role_id").(string)
+
+	role := &keycloak.Role{
+		RealmId: realmId,
+		Id:      roleId,
+	}
+
+	compositeRole, err := keycloakClient.GetRole(ctx, realmId, compositeRoleId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	err = keycloakClient.RemoveCompositesFromRole(ctx, role, []*keycloak.Role{compositeRole})
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRoleCompositeImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{roleId}}/{{compositeRoleId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("role_id", parts[1])
+	d.Set("composite_role_id", parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakRoleComposite_basic(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	parentRoleName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRoleComposite_basic(clientId, parentRoleName, realmRoleName, clientRoleName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{realmRoleName, clientRoleName}),
+					resource.TestCheckResourceAttrPair("keycloak_role_composite.realm_role", "composite_role_id", "keycloak_role.realm_role", "id"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_role_composite.client_role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the role is updated to make sure the composites are left alone
+				Config: testKeycloakRoleComposite_basic(clientId, parentRoleName, realmRoleName, clientRoleName, "updated"),
+				Check:  testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{realmRoleName, clientRoleName}),
+			},
+			{
+				Config: testKeycloakRoleComposite_roles(clientId, parentRoleName, realmRoleName, clientRoleName, ""),
+				Check:  testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{}),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRoleComposite_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	parentRoleName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRoleComposite_basic(clientId, parentRoleName, realmRoleName, clientRoleName, ""),
+				Check:  testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{realmRoleName, clientRoleName}),
+			},
+			{
+				PreConfig: func() {
+					parentRole, err := keycloakClient.GetRoleByName(testCtx, testAccRealm.Realm, "", parentRoleName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					composites, err := keycloakClient.GetRoleComposites(testCtx, parentRole)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.RemoveCompositesFromRole(testCtx, parentRole, composites)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRoleComposite_basic(clientId, parentRoleName, realmRoleName, clientRoleName, ""),
+				Check:  testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{realmRoleName, clientRoleName}),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRoleComposite_cycle(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	parentRoleName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRoleComposite_cycle(clientId, parentRoleName, realmRoleName, clientRoleName),
+				ExpectError: regexp.MustCompile("would create a cycle"),
+			},
+		},
+	})
+}
+
+func testKeycloakRoleComposite_roles(clientId, parentRoleName, realmRoleName, clientRoleName, description string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_role" "parent" {
+	realm_id    = data.keycloak_realm.realm.id
+	name        = "%s"
+	description = "%s"
+
+	lifecycle {
+		ignore_changes = [composite_roles]
+	}
+}
+
+resource "keycloak_role" "realm_role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "%s"
+}
+	`, testAccRealm.Realm, clientId, parentRoleName, description, realmRoleName, clientRoleName)
+}
+
+func testKeycloakRoleComposite_basic(clientId, parentRoleName, realmRoleName, clientRoleName, description string) string {
+	return testKeycloakRoleComposite_roles(clientId, parentRoleName, realmRoleName, clientRoleName, description) + `
+resource "keycloak_role_composite" "realm_role" {
+	realm_id          = data.keycloak_realm.realm.id
+	role_id           = keycloak_role.parent.id
+	composite_role_id = keycloak_role.realm_role.id
+}
+
+resource "keycloak_role_composite" "client_role" {
+	realm_id          = data.keycloak_realm.realm.id
+	role_id           = keycloak_role.parent.id
+	composite_role_id = keycloak_role.client_role.id
+}
+	`
+}
+
+func testKeycloakRoleComposite_cycle(clientId, parentRoleName, realmRoleName, clientRoleName string) string {
+	return testKeycloakRoleComposite_roles(clientId, parentRoleName, realmRoleName, clientRoleName, "") + `
+resource "keycloak_role_composite" "realm_role" {
+	realm_id          = data.keycloak_realm.realm.id
+	role_id           = keycloak_role.parent.id
+	composite_role_id = keycloak_role.realm_role.id
+}
+
+resource "keycloak_role_composite" "client_role" {
+	realm_id          = data.keycloak_realm.realm.id
+	role_id           = keycloak_role.realm_role.id
+	composite_role_id = keycloak_role.client_role.id
+}
+
+resource "keycloak_role_composite" "cycle" {
+	realm_id          = data.keycloak_realm.realm.id
+	role_id           = keycloak_role.client_role.id
+	composite_role_id = keycloak_role.parent.id
+
+	depends_on = [
+		keycloak_role_composite.realm_role,
+		keycloak_role_composite.client_role,
+	]
+}
+	`
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRoleComposites() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRoleCompositesReconcile,
+		ReadContext:   resourceKeycloakRoleCompositesRead,
+		UpdateContext: resourceKeycloakRoleCompositesReconcile,
+		DeleteContext: resourceKeycloakRoleCompositesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRoleCompositesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the composite role.",
+			},
+			"composite_roles": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Required:    true,
+				Description: "The ids of the realm and client roles that make up the composite role. Any other composites of the role are removed.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakRoleCompositesReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	roleId := data.Get("role_id").(string)
+
+	role, err := keycloakClient.GetRole(ctx, realmId, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keycloakComposites, err := keycloakClient.GetRoleComposites(ctx, role)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tfCompositeIds := data.Get("composite_roles").(*schema.Set)
+
+	var compositesToRemove []*keycloak.Role
+	keycloakCompositeIds := map[string]bool{}
+	for _, keycloakComposite := range keycloakComposites {
+		keycloakCompositeIds[keycloakComposite.Id] = true
+
+		if !tfCompositeIds.Contains(keycloakComposite.Id) {
+			compositesToRemove = append(compositesToRemove, keycloakComposite)
+		}
+	}
+
+	var compositesToAdd []*keycloak.Role
+	for _, tfCompositeId := range tfCompositeIds.List() {
+		if keycloakCompositeIds[tfCompositeId.(string)] {
+			continue
+		}
+
+		compositeToAdd, err := keycloakClient.GetRole(ctx, realmId, tfCompositeId.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		compositesToAdd = append(compositesToAdd, compositeToAdd)
+	}
+
+	// cycles are detected before anything is changed, so a rejected configuration leaves the role untouched
+	if err = keycloakClient.ValidateRoleComposites(ctx, role, compositesToAdd); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(compositesToRemove) != 0 {
+		if err = keycloakClient.RemoveCompositesFromRole(ctx, role, compositesToRemove); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if len(compositesToAdd) != 0 {
+		if err = keycloakClient.AddCompositesToRole(ctx, role, compositesToAdd); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, roleId))
+
+	return resourceKeycloakRoleCompositesRead(ctx, data, meta)
+}
+
+func resourceKeycloakRoleCompositesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	roleId := data.Get("role_id").(string)
+
+	role, err := keycloakClient.GetRole(ctx, realmId, roleId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	composites, err := keycloakClient.GetRoleComposites(ctx, role)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var compositeRoleIds []string
+	for _, composite := range composites {
+		compositeRoleIds = append(compositeRoleIds, composite.Id)
+	}
+
+	data.Set("composite_roles", compositeRoleIds)
+
+	return nil
+}
+
+func resourceKeycloakRoleCompositesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	roleId := data.Get("role_id").(string)
+
+	role := &keycloak.Role{
+		RealmId: realmId,
+		Id:      roleId,
+	}
+
+	var compositesToRemove []*keycloak.Role
+	for _, compositeRoleId := range data.Get("composite_roles").(*schema.Set).List() {
+		compositesToRemove = append(compositesToRemove, &keycloak.Role{
+			RealmId: realmId,
+			Id:      compositeRoleId.(string),
+		})
+	}
+
+	if len(compositesToRemove) == 0 {
+		return nil
+	}
+
+	err := keycloakClient.RemoveCompositesFromRole(ctx, role, compositesToRemove)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRoleCompositesImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{roleId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("role_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
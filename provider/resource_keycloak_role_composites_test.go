@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRoleComposites_basic(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	parentRoleName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleOneName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleTwoName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRoleComposites_basic(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName, "[keycloak_role.realm_role_one.id, keycloak_role.client_role.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{realmRoleOneName, clientRoleName}),
+					resource.TestCheckResourceAttr("keycloak_role_composites.composites", "composite_roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_role_composites.composites",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRoleComposites_basic(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName, "[keycloak_role.realm_role_two.id, keycloak_role.client_role.id]"),
+				Check:  testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{realmRoleTwoName, clientRoleName}),
+			},
+			{
+				Config: testKeycloakRoleComposites_basic(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName, "[]"),
+				Check:  testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{}),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRoleComposites_updateAfterManualChange(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	parentRoleName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleOneName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleTwoName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRoleComposites_basic(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName, "[keycloak_role.realm_role_one.id]"),
+				Check:  testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{realmRoleOneName}),
+			},
+			{
+				PreConfig: func() {
+					parentRole, err := keycloakClient.GetRoleByName(testCtx, testAccRealm.Realm, "", parentRoleName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					realmRoleTwo, err := keycloakClient.GetRoleByName(testCtx, testAccRealm.Realm, "", realmRoleTwoName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.AddCompositesToRole(testCtx, parentRole, []*keycloak.Role{realmRoleTwo})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRoleComposites_basic(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName, "[keycloak_role.realm_role_one.id]"),
+				Check:  testAccCheckKeycloakRoleHasComposites("keycloak_role.parent", []string{realmRoleOneName}),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRoleComposites_cycle(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	parentRoleName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleOneName := acctest.RandomWithPrefix("tf-acc")
+	realmRoleTwoName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRoleComposites_cycle(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName),
+				ExpectError: regexp.MustCompile("would create a cycle"),
+			},
+		},
+	})
+}
+
+func testKeycloakRoleComposites_roles(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_role" "parent" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+
+	lifecycle {
+		ignore_changes = [composite_roles]
+	}
+}
+
+resource "keycloak_role" "realm_role_one" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_role" "realm_role_two" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "%s"
+
+	lifecycle {
+		ignore_changes = [composite_roles]
+	}
+}
+	`, testAccRealm.Realm, clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName)
+}
+
+func testKeycloakRoleComposites_basic(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName, compositeRoles string) string {
+	return testKeycloakRoleComposites_roles(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName) + fmt.Sprintf(`
+resource "keycloak_role_composites" "composites" {
+	realm_id        = data.keycloak_realm.realm.id
+	role_id         = keycloak_role.parent.id
+	composite_roles = %s
+}
+	`, compositeRoles)
+}
+
+func testKeycloakRoleComposites_cycle(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName string) string {
+	return testKeycloakRoleComposites_roles(clientId, parentRoleName, realmRoleOneName, realmRoleTwoName, clientRoleName) + `
+resource "keycloak_role_composites" "composites" {
+	realm_id        = data.keycloak_realm.realm.id
+	role_id         = keycloak_role.parent.id
+	composite_roles = [keycloak_role.client_role.id]
+}
+
+resource "keycloak_role_composites" "cycle" {
+	realm_id        = data.keycloak_realm.realm.id
+	role_id         = keycloak_role.client_role.id
+	composite_roles = [keycloak_role.parent.id]
+
+	depends_on = [keycloak_role_composites.composites]
+}
+	`
+}
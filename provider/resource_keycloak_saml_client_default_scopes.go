@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +18,10 @@ func resourceKeycloakSamlClientDefaultScopes() *schema.Resource {
 		ReadContext:   resourceKeycloakSamlClientDefaultScopesRead,
 		DeleteContext: resourceKeycloakSamlClientDefaultScopesDelete,
 		UpdateContext: resourceKeycloakSamlClientDefaultScopesUpdate,
+		// This resource can be imported using {{realm}}/{{client_id}}, where client_id is the unique ID of the client
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakSamlClientDefaultScopesImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -153,3 +160,30 @@ func resourceKeycloakSamlClientDefaultScopesDelete(ctx context.Context, data *sc
 
 	return diag.FromErr(keycloakClient.DetachSamlClientDefaultScopes(ctx, realmId, clientId, interfaceSliceToStringSlice(defaultScopes.List())))
 }
+
+func resourceKeycloakSamlClientDefaultScopesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{samlClientId}}")
+	}
+
+	realmId := parts[0]
+	clientId := parts[1]
+
+	if _, err := keycloakClient.GetSamlClient(ctx, realmId, clientId); err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("client_id", clientId)
+	d.SetId(samlClientDefaultScopesId(realmId, clientId))
+
+	diagnostics := resourceKeycloakSamlClientDefaultScopesRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -240,6 +240,7 @@ func getSamlIdentityProviderFromData(data *schema.ResourceData, keycloakVersion
 
 func setSamlIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, keycloakVersion *version.Version) error {
 	setIdentityProviderData(data, identityProvider, keycloakVersion)
+	data.Set("provider_id", identityProvider.ProviderId)
 
 	var nameIDPolicyFormat string
 	for k, v := range nameIdPolicyFormats {
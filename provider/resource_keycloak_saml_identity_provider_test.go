@@ -27,6 +27,12 @@ func TestAccKeycloakSamlIdentityProvider_basic(t *testing.T) {
 				Config: testKeycloakSamlIdentityProvider_basic(samlName),
 				Check:  testAccCheckKeycloakSamlIdentityProviderExists("keycloak_saml_identity_provider.saml"),
 			},
+			{
+				ResourceName:        "keycloak_saml_identity_provider.saml",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
 		},
 	})
 }
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakStackoverflowIdentityProvider() *schema.Resource {
+	configAttributes := map[string]socialIdentityProviderConfigAttribute{
+		"key": {
+			configKey: "key",
+			schema: &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The key obtained when registering the application with Stack Apps.",
+			},
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("stackoverflow", "Stack Overflow", configAttributes)
+}
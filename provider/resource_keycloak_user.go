@@ -9,6 +9,7 @@ import (
 	"dario.cat/mergo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
@@ -73,6 +74,8 @@ func resourceKeycloakUser() *schema.Resource {
 			"federated_identity": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				// links that are managed by keycloak_user_federated_identity are read back, but don't cause a diff when this is omitted
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"identity_provider": {
@@ -95,6 +98,7 @@ func resourceKeycloakUser() *schema.Resource {
 				Optional:         true,
 				DiffSuppressFunc: onlyDiffOnCreate,
 				MaxItems:         1,
+				ConflictsWith:    []string{"initial_hashed_password"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"value": {
@@ -110,6 +114,36 @@ func resourceKeycloakUser() *schema.Resource {
 					},
 				},
 			},
+			"initial_hashed_password": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: onlyDiffOnCreate,
+				MaxItems:         1,
+				ConflictsWith:    []string{"initial_password"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hashed_salted_value": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"salt": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"hash_iterations": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 			"enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -212,6 +246,24 @@ func resourceKeycloakUserCreate(ctx context.Context, data *schema.ResourceData,
 	user := mapFromDataToUser(data)
 
 	if !data.Get("import").(bool) {
+		// a hashed password is sent along with the new user, so that it's never set in plain text
+		if v, ok := data.GetOk("initial_hashed_password"); ok {
+			hashedPasswordBlock := v.([]interface{})[0].(map[string]interface{})
+			algorithm := hashedPasswordBlock["algorithm"].(string)
+
+			err := keycloakClient.ValidatePasswordHashingAlgorithm(ctx, algorithm)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			credential, err := keycloak.NewHashedPasswordCredential(hashedPasswordBlock["hashed_salted_value"].(string), hashedPasswordBlock["salt"].(string), algorithm, hashedPasswordBlock["hash_iterations"].(int))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			user.Credentials = []*keycloak.UserCredential{credential}
+		}
+
 		err := keycloakClient.NewUser(ctx, user)
 		if err != nil {
 			return diag.FromErr(err)
@@ -244,6 +296,13 @@ func resourceKeycloakUserCreate(ctx context.Context, data *schema.ResourceData,
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		if _, ok := data.GetOk("federated_identity"); ok {
+			err = keycloakClient.UpdateUserFederatedIdentities(ctx, user.RealmId, user.Id, user.FederatedIdentities)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	mapFromUserToData(data, user)
@@ -281,6 +340,15 @@ func resourceKeycloakUserUpdate(ctx context.Context, data *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	// the links are only reconciled when they are set within the configuration, so links that are managed by
+	// keycloak_user_federated_identity are left alone
+	if data.HasChange("federated_identity") {
+		err = keycloakClient.UpdateUserFederatedIdentities(ctx, user.RealmId, user.Id, user.FederatedIdentities)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	mapFromUserToData(data, user)
 
 	return nil
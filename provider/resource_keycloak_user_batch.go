@@ -0,0 +1,718 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// the number of users created by a single partial import request
+const keycloakUserBatchPartialImportSize = 500
+
+func resourceKeycloakUserBatch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserBatchCreate,
+		ReadContext:   resourceKeycloakUserBatchRead,
+		UpdateContext: resourceKeycloakUserBatchUpdate,
+		DeleteContext: resourceKeycloakUserBatchDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "The maximum number of requests that are sent to Keycloak at the same time.",
+			},
+			"user": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+								username := i.(string)
+
+								if strings.ToLower(username) != username {
+									return nil, []error{fmt.Errorf("expected username %s to be all lowercase", username)}
+								}
+
+								return nil, nil
+							},
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"email_verified": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+						"groups": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "The paths of the groups the user is a member of, such as /parent/child.",
+						},
+						"realm_roles": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "The names of the realm roles that are assigned to the user.",
+						},
+					},
+				},
+			},
+			"user_ids": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"user_hashes": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ComputedIf("user_ids", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("user")
+			}),
+			customdiff.ComputedIf("user_hashes", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("user")
+			}),
+		),
+	}
+}
+
+type keycloakBatchUser struct {
+	user       *keycloak.User
+	groups     []string
+	realmRoles []string
+}
+
+func mapFromDataToBatchUser(realmId string, userData map[string]interface{}) *keycloakBatchUser {
+	attributes := map[string][]string{}
+	for k, v := range userData["attributes"].(map[string]interface{}) {
+		attributes[k] = strings.Split(v.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	groups := interfaceSliceToStringSlice(userData["groups"].(*schema.Set).List())
+	sort.Strings(groups)
+
+	realmRoles := interfaceSliceToStringSlice(userData["realm_roles"].(*schema.Set).List())
+	sort.Strings(realmRoles)
+
+	return &keycloakBatchUser{
+		user: &keycloak.User{
+			RealmId:       realmId,
+			Username:      userData["username"].(string),
+			Email:         userData["email"].(string),
+			EmailVerified: userData["email_verified"].(bool),
+			FirstName:     userData["first_name"].(string),
+			LastName:      userData["last_name"].(string),
+			Enabled:       userData["enabled"].(bool),
+			Attributes:    attributes,
+		},
+		groups:     groups,
+		realmRoles: realmRoles,
+	}
+}
+
+func mapFromBatchUserToData(batchUser *keycloakBatchUser) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	for k, v := range batchUser.user.Attributes {
+		attributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	return map[string]interface{}{
+		"username":       batchUser.user.Username,
+		"email":          batchUser.user.Email,
+		"email_verified": batchUser.user.EmailVerified,
+		"first_name":     batchUser.user.FirstName,
+		"last_name":      batchUser.user.LastName,
+		"enabled":        batchUser.user.Enabled,
+		"attributes":     attributes,
+		"groups":         batchUser.groups,
+		"realm_roles":    batchUser.realmRoles,
+	}
+}
+
+// hash is used to figure out which users have changed without comparing every single field of every user
+func (batchUser *keycloakBatchUser) hash() string {
+	attributes := map[string][]string{}
+	for k, v := range batchUser.user.Attributes {
+		attributes[k] = v
+	}
+
+	// json.Marshal sorts map keys, which keeps the hash stable
+	payload, _ := json.Marshal(map[string]interface{}{
+		"username":      batchUser.user.Username,
+		"email":         strings.ToLower(batchUser.user.Email),
+		"emailVerified": batchUser.user.EmailVerified,
+		"firstName":     batchUser.user.FirstName,
+		"lastName":      batchUser.user.LastName,
+		"enabled":       batchUser.user.Enabled,
+		"attributes":    attributes,
+		"groups":        batchUser.groups,
+		"realmRoles":    batchUser.realmRoles,
+	})
+
+	sum := sha256.Sum256(payload)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func getBatchUsersFromData(realmId string, usersData *schema.Set) ([]*keycloakBatchUser, error) {
+	var batchUsers []*keycloakBatchUser
+	usernames := make(map[string]bool)
+
+	for _, userData := range usersData.List() {
+		batchUser := mapFromDataToBatchUser(realmId, userData.(map[string]interface{}))
+		if usernames[batchUser.user.Username] {
+			return nil, fmt.Errorf("validation error: user %s is specified more than once", batchUser.user.Username)
+		}
+
+		usernames[batchUser.user.Username] = true
+		batchUsers = append(batchUsers, batchUser)
+	}
+
+	return batchUsers, nil
+}
+
+// runConcurrently calls fn for every index up to count, with at most concurrency calls running at the same time
+func runConcurrently(concurrency, count int, fn func(i int) error) error {
+	errs := make([]error, count)
+	semaphore := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			errs[i] = fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// keycloakUserBatchLookups caches the ids of the groups and roles used by the batch, as most users tend to share them
+type keycloakUserBatchLookups struct {
+	keycloakClient *keycloak.KeycloakClient
+	realmId        string
+
+	mutex      sync.Mutex
+	groupIds   map[string]string
+	realmRoles map[string]*keycloak.Role
+}
+
+func (lookups *keycloakUserBatchLookups) groupIdsByPath(ctx context.Context, paths []string) ([]string, error) {
+	var groupIds []string
+
+	for _, path := range paths {
+		lookups.mutex.Lock()
+		groupId, ok := lookups.groupIds[path]
+		lookups.mutex.Unlock()
+
+		if !ok {
+			group, err := lookups.keycloakClient.GetGroupByPath(ctx, lookups.realmId, path)
+			if err != nil {
+				return nil, err
+			}
+
+			groupId = group.Id
+
+			lookups.mutex.Lock()
+			lookups.groupIds[path] = groupId
+			lookups.mutex.Unlock()
+		}
+
+		groupIds = append(groupIds, groupId)
+	}
+
+	return groupIds, nil
+}
+
+func (lookups *keycloakUserBatchLookups) realmRolesByName(ctx context.Context, names []string) ([]*keycloak.Role, error) {
+	var roles []*keycloak.Role
+
+	for _, name := range names {
+		lookups.mutex.Lock()
+		role, ok := lookups.realmRoles[name]
+		lookups.mutex.Unlock()
+
+		if !ok {
+			var err error
+
+			role, err = lookups.keycloakClient.GetRoleByName(ctx, lookups.realmId, "", name)
+			if err != nil {
+				return nil, err
+			}
+
+			lookups.mutex.Lock()
+			lookups.realmRoles[name] = role
+			lookups.mutex.Unlock()
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+// updateBatchUser updates an existing user. Only the group memberships and roles that were previously managed by this
+// resource are removed, so the ones that were assigned outside of terraform are left alone.
+func updateBatchUser(ctx context.Context, keycloakClient *keycloak.KeycloakClient, lookups *keycloakUserBatchLookups, userId string, desired, previous *keycloakBatchUser) error {
+	realmId := desired.user.RealmId
+
+	user := *desired.user
+	user.Id = userId
+
+	err := keycloakClient.UpdateUser(ctx, &user)
+	if err != nil {
+		return err
+	}
+
+	var previousGroups, previousRealmRoles []string
+	if previous != nil {
+		previousGroups = previous.groups
+		previousRealmRoles = previous.realmRoles
+	}
+
+	groupsToAdd, err := lookups.groupIdsByPath(ctx, stringArrayDifference(desired.groups, previousGroups))
+	if err != nil {
+		return err
+	}
+
+	err = keycloakClient.AddUserToGroups(ctx, groupsToAdd, userId, realmId)
+	if err != nil {
+		return err
+	}
+
+	groupsToRemove, err := lookups.groupIdsByPath(ctx, stringArrayDifference(previousGroups, desired.groups))
+	if err != nil {
+		return err
+	}
+
+	err = keycloakClient.RemoveUserFromGroups(ctx, groupsToRemove, userId, realmId)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return err
+	}
+
+	realmRolesToAdd, err := lookups.realmRolesByName(ctx, stringArrayDifference(desired.realmRoles, previousRealmRoles))
+	if err != nil {
+		return err
+	}
+
+	if len(realmRolesToAdd) != 0 {
+		err = keycloakClient.AddRealmRolesToUser(ctx, realmId, userId, realmRolesToAdd)
+		if err != nil {
+			return err
+		}
+	}
+
+	realmRolesToRemove, err := lookups.realmRolesByName(ctx, stringArrayDifference(previousRealmRoles, desired.realmRoles))
+	if err != nil {
+		return err
+	}
+
+	if len(realmRolesToRemove) != 0 {
+		err = keycloakClient.RemoveRealmRolesFromUser(ctx, realmId, userId, realmRolesToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createBatchUsers creates the given users, and records the ids of the users that were created in createdUserIds. Users are
+// only recorded once the partial import of their chunk succeeded, as keycloak rejects the whole chunk when one of them exists.
+func createBatchUsers(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, concurrency int, batchUsers []*keycloakBatchUser, createdUserIds map[string]string) error {
+	var chunks [][]*keycloak.PartialImportUser
+	for start := 0; start < len(batchUsers); start += keycloakUserBatchPartialImportSize {
+		end := min(start+keycloakUserBatchPartialImportSize, len(batchUsers))
+
+		var chunk []*keycloak.PartialImportUser
+		for _, batchUser := range batchUsers[start:end] {
+			chunk = append(chunk, &keycloak.PartialImportUser{
+				User:       batchUser.user,
+				Groups:     batchUser.groups,
+				RealmRoles: batchUser.realmRoles,
+			})
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	var mutex sync.Mutex
+
+	return runConcurrently(concurrency, len(chunks), func(i int) error {
+		response, err := keycloakClient.PartialImportUsers(ctx, realmId, chunks[i], "FAIL")
+		if err != nil {
+			return err
+		}
+
+		mutex.Lock()
+		for _, result := range response.Results {
+			if result.Action == "ADDED" && result.ResourceType == "USER" {
+				createdUserIds[result.ResourceName] = result.Id
+			}
+		}
+		mutex.Unlock()
+
+		if response.Added != len(chunks[i]) {
+			return fmt.Errorf("expected %d users to be created in realm %s, but %d were created", len(chunks[i]), realmId, response.Added)
+		}
+
+		return nil
+	})
+}
+
+// resourceKeycloakUserBatchApply diffs the configured users against the users within the realm. Users that already exist
+// within the realm, but aren't tracked in user_ids, are never taken over, as they would be deleted along with this resource.
+// The ids of the users that are managed by this resource are returned by username, even if the apply failed halfway.
+func resourceKeycloakUserBatchApply(ctx context.Context, data *schema.ResourceData, keycloakClient *keycloak.KeycloakClient) (map[string]string, error) {
+	realmId := data.Get("realm_id").(string)
+	concurrency := data.Get("concurrency").(int)
+
+	oldUsersData, newUsersData := data.GetChange("user")
+	oldHashes, _ := data.GetChange("user_hashes")
+	oldUserIds, _ := data.GetChange("user_ids")
+
+	previousUsers, err := getBatchUsersFromData(realmId, oldUsersData.(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+
+	desiredUsers, err := getBatchUsersFromData(realmId, newUsersData.(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+
+	previousUsersByUsername := make(map[string]*keycloakBatchUser)
+	for _, previousUser := range previousUsers {
+		previousUsersByUsername[previousUser.user.Username] = previousUser
+	}
+
+	realmUsers, err := keycloakClient.SearchUsers(ctx, realmId, map[string]string{"briefRepresentation": "false"})
+	if err != nil {
+		return nil, err
+	}
+
+	realmUsersByUsername := make(map[string]*keycloak.User)
+	managedUserIds := make(map[string]string)
+	for _, realmUser := range realmUsers {
+		realmUsersByUsername[realmUser.Username] = realmUser
+
+		if oldUserIds.(map[string]interface{})[realmUser.Username] == realmUser.Id {
+			managedUserIds[realmUser.Username] = realmUser.Id
+		}
+	}
+
+	var usersToCreate, usersToUpdate []*keycloakBatchUser
+	var unmanagedUsernames []string
+	desiredUsernames := make(map[string]bool)
+
+	for _, desiredUser := range desiredUsers {
+		username := desiredUser.user.Username
+		desiredUsernames[username] = true
+
+		if _, ok := realmUsersByUsername[username]; !ok {
+			usersToCreate = append(usersToCreate, desiredUser)
+		} else if _, ok := managedUserIds[username]; !ok {
+			unmanagedUsernames = append(unmanagedUsernames, username)
+		} else if oldHashes.(map[string]interface{})[username] != desiredUser.hash() {
+			usersToUpdate = append(usersToUpdate, desiredUser)
+		}
+	}
+
+	if len(unmanagedUsernames) != 0 {
+		sort.Strings(unmanagedUsernames)
+
+		return managedUserIds, fmt.Errorf("validation error: users %s already exist within realm %s and are not managed by this resource", strings.Join(unmanagedUsernames, ", "), realmId)
+	}
+
+	var userIdsToDelete []string
+	for _, previousUser := range previousUsers {
+		username := previousUser.user.Username
+		if _, ok := managedUserIds[username]; ok && !desiredUsernames[username] {
+			userIdsToDelete = append(userIdsToDelete, realmUsersByUsername[username].Id)
+		}
+	}
+
+	err = runConcurrently(concurrency, len(userIdsToDelete), func(i int) error {
+		err := keycloakClient.DeleteUser(ctx, realmId, userIdsToDelete[i])
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return managedUserIds, err
+	}
+
+	err = createBatchUsers(ctx, keycloakClient, realmId, concurrency, usersToCreate, managedUserIds)
+	if err != nil {
+		return managedUserIds, err
+	}
+
+	lookups := &keycloakUserBatchLookups{
+		keycloakClient: keycloakClient,
+		realmId:        realmId,
+		groupIds:       make(map[string]string),
+		realmRoles:     make(map[string]*keycloak.Role),
+	}
+
+	return managedUserIds, runConcurrently(concurrency, len(usersToUpdate), func(i int) error {
+		desiredUser := usersToUpdate[i]
+		realmUser := realmUsersByUsername[desiredUser.user.Username]
+
+		return updateBatchUser(ctx, keycloakClient, lookups, realmUser.Id, desiredUser, previousUsersByUsername[desiredUser.user.Username])
+	})
+}
+
+func resourceKeycloakUserBatchCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	data.SetId(data.Get("realm_id").(string))
+
+	// the users that were created before a failure are kept in state, so they are deleted when the tainted resource is replaced
+	managedUserIds, err := resourceKeycloakUserBatchApply(ctx, data, keycloakClient)
+	diags := readKeycloakUserBatch(ctx, data, keycloakClient, func(realmUser *keycloak.User) bool {
+		return managedUserIds[realmUser.Username] == realmUser.Id
+	})
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceKeycloakUserBatchRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	userIds := data.Get("user_ids").(map[string]interface{})
+
+	return readKeycloakUserBatch(ctx, data, keycloakClient, func(realmUser *keycloak.User) bool {
+		return userIds[realmUser.Username] == realmUser.Id
+	})
+}
+
+// readKeycloakUserBatch refreshes the configured users that are managed by this resource. Users that were removed from the
+// configuration are kept as long as they still exist, so their deletion is retried when it failed.
+func readKeycloakUserBatch(ctx context.Context, data *schema.ResourceData, keycloakClient *keycloak.KeycloakClient, isManaged func(realmUser *keycloak.User) bool) diag.Diagnostics {
+	realmId := data.Get("realm_id").(string)
+	concurrency := data.Get("concurrency").(int)
+
+	oldUsersData, newUsersData := data.GetChange("user")
+
+	batchUsers, err := getBatchUsersFromData(realmId, newUsersData.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	previousUsers, err := getBatchUsersFromData(realmId, oldUsersData.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	usernames := make(map[string]bool)
+	for _, batchUser := range batchUsers {
+		usernames[batchUser.user.Username] = true
+	}
+
+	for _, previousUser := range previousUsers {
+		if !usernames[previousUser.user.Username] {
+			batchUsers = append(batchUsers, previousUser)
+		}
+	}
+
+	realmUsers, err := keycloakClient.SearchUsers(ctx, realmId, map[string]string{"briefRepresentation": "false"})
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	realmUsersByUsername := make(map[string]*keycloak.User)
+	for _, realmUser := range realmUsers {
+		realmUsersByUsername[realmUser.Username] = realmUser
+	}
+
+	var refreshedUsers []*keycloakBatchUser
+	for _, batchUser := range batchUsers {
+		realmUser, ok := realmUsersByUsername[batchUser.user.Username]
+		if !ok || !isManaged(realmUser) {
+			// the user will be created again during the next apply
+			continue
+		}
+
+		// keycloak stores emails in lowercase
+		if strings.EqualFold(realmUser.Email, batchUser.user.Email) {
+			realmUser.Email = batchUser.user.Email
+		}
+
+		refreshedUsers = append(refreshedUsers, &keycloakBatchUser{
+			user:       realmUser,
+			groups:     batchUser.groups,
+			realmRoles: batchUser.realmRoles,
+		})
+	}
+
+	err = runConcurrently(concurrency, len(refreshedUsers), func(i int) error {
+		return readBatchUserMemberships(ctx, keycloakClient, refreshedUsers[i])
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var usersData []interface{}
+	userIds := make(map[string]string)
+	userHashes := make(map[string]string)
+
+	for _, refreshedUser := range refreshedUsers {
+		usersData = append(usersData, mapFromBatchUserToData(refreshedUser))
+		userIds[refreshedUser.user.Username] = refreshedUser.user.Id
+		userHashes[refreshedUser.user.Username] = refreshedUser.hash()
+	}
+
+	data.Set("user", usersData)
+	data.Set("user_ids", userIds)
+	data.Set("user_hashes", userHashes)
+
+	return nil
+}
+
+// readBatchUserMemberships narrows the groups and realm roles of the user down to the ones it actually has, so memberships
+// and roles that were removed outside of terraform are added again. Memberships and roles that aren't managed by this
+// resource are ignored, and users without any groups or realm roles don't need any additional requests.
+func readBatchUserMemberships(ctx context.Context, keycloakClient *keycloak.KeycloakClient, batchUser *keycloakBatchUser) error {
+	realmId := batchUser.user.RealmId
+	userId := batchUser.user.Id
+
+	if len(batchUser.groups) != 0 {
+		userGroups, err := keycloakClient.GetUserGroups(ctx, realmId, userId)
+		if err != nil {
+			return err
+		}
+
+		var groupPaths []string
+		for _, userGroup := range userGroups {
+			groupPaths = append(groupPaths, userGroup.Path)
+		}
+
+		var groups []string
+		for _, group := range batchUser.groups {
+			if stringSliceContains(groupPaths, group) {
+				groups = append(groups, group)
+			}
+		}
+
+		batchUser.groups = groups
+	}
+
+	if len(batchUser.realmRoles) != 0 {
+		roleMappings, err := keycloakClient.GetUserRoleMappings(ctx, realmId, userId)
+		if err != nil {
+			return err
+		}
+
+		var roleNames []string
+		for _, role := range roleMappings.RealmMappings {
+			roleNames = append(roleNames, role.Name)
+		}
+
+		var realmRoles []string
+		for _, realmRole := range batchUser.realmRoles {
+			if stringSliceContains(roleNames, realmRole) {
+				realmRoles = append(realmRoles, realmRole)
+			}
+		}
+
+		batchUser.realmRoles = realmRoles
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserBatchUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// the users are read back even if the apply failed, so the users that were created before the failure are kept in state
+	managedUserIds, err := resourceKeycloakUserBatchApply(ctx, data, keycloakClient)
+	diags := readKeycloakUserBatch(ctx, data, keycloakClient, func(realmUser *keycloak.User) bool {
+		return managedUserIds[realmUser.Username] == realmUser.Id
+	})
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceKeycloakUserBatchDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	concurrency := data.Get("concurrency").(int)
+
+	var userIds []string
+	for _, userId := range data.Get("user_ids").(map[string]interface{}) {
+		userIds = append(userIds, userId.(string))
+	}
+
+	err := runConcurrently(concurrency, len(userIds), func(i int) error {
+		err := keycloakClient.DeleteUser(ctx, realmId, userIds[i])
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return err
+		}
+
+		return nil
+	})
+
+	return diag.FromErr(err)
+}
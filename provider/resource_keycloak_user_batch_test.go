@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakUserBatch_basic(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserBatchHasUsers(prefix, 0),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserBatch_basic(prefix, 120, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserBatchHasUsers(prefix, 120),
+					resource.TestCheckResourceAttr("keycloak_user_batch.users", "user.#", "120"),
+					resource.TestCheckResourceAttr("keycloak_user_batch.users", "user_ids.%", "120"),
+					resource.TestCheckResourceAttr("keycloak_user_batch.users", "user_hashes.%", "120"),
+					testAccCheckKeycloakUserBatchUserMemberships("keycloak_user_batch.users", prefix+"-0"),
+				),
+			},
+			{
+				Config:   testKeycloakUserBatch_basic(prefix, 120, "first"),
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakUserBatch_basic(prefix, 80, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserBatchHasUsers(prefix, 80),
+					resource.TestCheckResourceAttr("keycloak_user_batch.users", "user.#", "80"),
+					resource.TestCheckResourceAttr("keycloak_user_batch.users", "user_ids.%", "80"),
+					testAccCheckKeycloakUserBatchUserFirstName("keycloak_user_batch.users", prefix+"-79", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserBatch_updateAfterManualChange(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserBatchHasUsers(prefix, 0),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserBatch_basic(prefix, 5, "first"),
+				Check:  testAccCheckKeycloakUserBatchHasUsers(prefix, 5),
+			},
+			{
+				PreConfig: func() {
+					users, err := keycloakClient.SearchUsers(testCtx, testAccRealm.Realm, map[string]string{"username": prefix + "-1", "exact": "true"})
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					user := users[0]
+					user.FirstName = "changed"
+
+					err = keycloakClient.UpdateUser(testCtx, user)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUserBatch_basic(prefix, 5, "first"),
+				Check:  testAccCheckKeycloakUserBatchUserFirstName("keycloak_user_batch.users", prefix+"-1", "first"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserBatch_duplicateUsername(t *testing.T) {
+	t.Parallel()
+
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUserBatch_duplicateUsername(username),
+				ExpectError: regexp.MustCompile("is specified more than once"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserBatch_existingUser(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserBatchHasUsers(prefix, 0),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					err := keycloakClient.NewUser(testCtx, &keycloak.User{
+						RealmId:  testAccRealm.Realm,
+						Username: prefix + "-existing",
+						Enabled:  true,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:      testKeycloakUserBatch_existingUser(prefix),
+				ExpectError: regexp.MustCompile("already exist within realm .* and are not managed by this resource"),
+			},
+			{
+				PreConfig: func() {
+					users, err := keycloakClient.SearchUsers(testCtx, testAccRealm.Realm, map[string]string{"username": prefix + "-existing", "exact": "true"})
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					// the user that existed before must not have been deleted or taken over
+					if len(users) != 1 {
+						t.Fatalf("expected user %s-existing to still exist", prefix)
+					}
+
+					err = keycloakClient.DeleteUser(testCtx, testAccRealm.Realm, users[0].Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUserBatch_existingUser(prefix),
+				Check:  testAccCheckKeycloakUserBatchHasUsers(prefix, 2),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserBatch_membershipsRemovedOutsideOfTerraform(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserBatchHasUsers(prefix, 0),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserBatch_basic(prefix, 2, "first"),
+				Check:  testAccCheckKeycloakUserBatchUserMemberships("keycloak_user_batch.users", prefix+"-1"),
+			},
+			{
+				PreConfig: func() {
+					users, err := keycloakClient.SearchUsers(testCtx, testAccRealm.Realm, map[string]string{"username": prefix + "-1", "exact": "true"})
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					groups, err := keycloakClient.GetUserGroups(testCtx, testAccRealm.Realm, users[0].Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.RemoveUserFromGroups(testCtx, []string{groups[0].Id}, users[0].Id, testAccRealm.Realm)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUserBatch_basic(prefix, 2, "first"),
+				Check:  testAccCheckKeycloakUserBatchUserMemberships("keycloak_user_batch.users", prefix+"-1"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserBatchHasUsers(prefix string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		users, err := keycloakClient.SearchUsers(testCtx, testAccRealm.Realm, map[string]string{"username": prefix + "-"})
+		if err != nil {
+			return err
+		}
+
+		if len(users) != expected {
+			return fmt.Errorf("expected realm %s to have %d users with prefix %s, got %d", testAccRealm.Realm, expected, prefix, len(users))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUserBatchUserMemberships(resourceName, username string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		userId := rs.Primary.Attributes["user_ids."+username]
+
+		groups, err := keycloakClient.GetUserGroups(testCtx, testAccRealm.Realm, userId)
+		if err != nil {
+			return err
+		}
+
+		if len(groups) != 1 {
+			return fmt.Errorf("expected user %s to be a member of 1 group, got %d", username, len(groups))
+		}
+
+		roleMappings, err := keycloakClient.GetUserRoleMappings(testCtx, testAccRealm.Realm, userId)
+		if err != nil {
+			return err
+		}
+
+		for _, role := range roleMappings.RealmMappings {
+			if role.Name == "offline_access" {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected user %s to have the offline_access realm role", username)
+	}
+}
+
+func testAccCheckKeycloakUserBatchUserFirstName(resourceName, username, firstName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		user, err := keycloakClient.GetUser(testCtx, testAccRealm.Realm, rs.Primary.Attributes["user_ids."+username])
+		if err != nil {
+			return err
+		}
+
+		if user.FirstName != firstName {
+			return fmt.Errorf("expected user %s to have first name %s, got %s", username, firstName, user.FirstName)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserBatch_basic(prefix string, count int, firstName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_user_batch" "users" {
+	realm_id = data.keycloak_realm.realm.id
+
+	dynamic "user" {
+		for_each = range(%d)
+		content {
+			username   = "%s-${user.value}"
+			email      = "%s-${user.value}@fakedomain.com"
+			first_name = "%s"
+			last_name  = "User ${user.value}"
+
+			attributes = {
+				index = tostring(user.value)
+			}
+
+			groups      = [keycloak_group.group.path]
+			realm_roles = ["offline_access"]
+		}
+	}
+}
+	`, testAccRealm.Realm, prefix, count, prefix, prefix, firstName)
+}
+
+func testKeycloakUserBatch_duplicateUsername(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user_batch" "users" {
+	realm_id = data.keycloak_realm.realm.id
+
+	user {
+		username   = "%s"
+		first_name = "First"
+	}
+
+	user {
+		username   = "%s"
+		first_name = "Second"
+	}
+}
+	`, testAccRealm.Realm, username, username)
+}
+
+func testKeycloakUserBatch_existingUser(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user_batch" "users" {
+	realm_id = data.keycloak_realm.realm.id
+
+	user {
+		username = "%s-new"
+	}
+
+	user {
+		username = "%s-existing"
+	}
+}
+	`, testAccRealm.Realm, prefix, prefix)
+}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+var (
+	keycloakUserCredentialOtpTypes      = []string{"totp", "hotp"}
+	keycloakUserCredentialOtpAlgorithms = []string{"HmacSHA1", "HmacSHA256", "HmacSHA512"}
+)
+
+func resourceKeycloakUserCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserCredentialCreate,
+		ReadContext:   resourceKeycloakUserCredentialRead,
+		DeleteContext: resourceKeycloakUserCredentialDelete,
+		// This resource can be imported using {{realm}}/{{user_id}}/{{credential_id}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakUserCredentialImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_label": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"replace_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "When true, every existing credential of the same type will be removed from the user before this credential is created.",
+			},
+			"otp": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"otp", "password"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "totp",
+							ValidateFunc: validation.StringInSlice(keycloakUserCredentialOtpTypes, false),
+						},
+						"algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "HmacSHA1",
+							ValidateFunc: validation.StringInSlice(keycloakUserCredentialOtpAlgorithms, false),
+						},
+						"digits": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      6,
+							ValidateFunc: validation.IntInSlice([]int{6, 8}),
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      30,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"initial_counter": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"password": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"otp", "password"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hashed_salted_value": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"salt": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"hash_iterations": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func userCredentialId(realmId, userId, credentialId string) string {
+	return fmt.Sprintf("%s/%s/%s", realmId, userId, credentialId)
+}
+
+func parseUserCredentialId(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{userId}}/{{credentialId}}")
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func getUserCredentialFromData(data *schema.ResourceData) (*keycloak.UserCredential, error) {
+	var credential *keycloak.UserCredential
+	var err error
+
+	if v, ok := data.GetOk("otp"); ok {
+		otp := v.([]interface{})[0].(map[string]interface{})
+		credential, err = keycloak.NewOtpCredential(
+			otp["secret"].(string),
+			otp["type"].(string),
+			otp["algorithm"].(string),
+			otp["digits"].(int),
+			otp["period"].(int),
+			otp["initial_counter"].(int),
+		)
+	} else {
+		password := data.Get("password").([]interface{})[0].(map[string]interface{})
+		credential, err = keycloak.NewHashedPasswordCredential(
+			password["hashed_salted_value"].(string),
+			password["salt"].(string),
+			password["algorithm"].(string),
+			password["hash_iterations"].(int),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	credential.UserLabel = data.Get("user_label").(string)
+
+	return credential, nil
+}
+
+func resourceKeycloakUserCredentialCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	credential, err := getUserCredentialFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if credential.Type == "password" {
+		err = keycloakClient.ValidatePasswordHashingAlgorithm(ctx, data.Get("password.0.algorithm").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.Get("replace_existing").(bool) {
+		err = keycloakClient.DeleteUserCredentialsByType(ctx, realmId, userId, credential.Type)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	existingCredentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// keycloak only keeps a single password per user, so an existing one would silently be overwritten
+	if credential.Type == "password" {
+		for _, existingCredential := range existingCredentials {
+			if existingCredential.Type == "password" {
+				return diag.Errorf("user with id %s already has a password credential, set replace_existing to true in order to replace it", userId)
+			}
+		}
+	}
+
+	err = keycloakClient.CreateUserCredentials(ctx, realmId, userId, []*keycloak.UserCredential{credential})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// keycloak doesn't return the id of the new credential, so it has to be found by comparing the credentials of the user
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingCredentialIds := make(map[string]bool)
+	for _, existingCredential := range existingCredentials {
+		existingCredentialIds[existingCredential.Id] = true
+	}
+
+	for _, c := range credentials {
+		if c.Type == credential.Type && !existingCredentialIds[c.Id] {
+			data.SetId(userCredentialId(realmId, userId, c.Id))
+			break
+		}
+	}
+
+	if data.Id() == "" {
+		return diag.Errorf("unable to find %s credential created for user with id %s", credential.Type, userId)
+	}
+
+	return resourceKeycloakUserCredentialRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserCredentialRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId, userId, credentialId, err := parseUserCredentialId(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	credential, err := keycloakClient.GetUserCredential(ctx, realmId, userId, credentialId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if credential == nil {
+		tflog.Warn(ctx, "Removing resource from state as the credential no longer exists", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+
+		return nil
+	}
+
+	data.Set("realm_id", realmId)
+	data.Set("user_id", userId)
+	data.Set("user_label", credential.UserLabel)
+	data.Set("type", credential.Type)
+	data.Set("created_date", credential.CreatedDate)
+
+	return nil
+}
+
+func resourceKeycloakUserCredentialDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId, userId, credentialId, err := parseUserCredentialId(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.DeleteUserCredential(ctx, realmId, userId, credentialId)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserCredentialImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId, userId, credentialId, err := parseUserCredentialId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := keycloakClient.GetUserCredential(ctx, realmId, userId, credentialId)
+	if err != nil {
+		return nil, err
+	}
+
+	if credential == nil {
+		return nil, fmt.Errorf("credential with id %s does not exist for user with id %s", credentialId, userId)
+	}
+
+	d.Set("replace_existing", false)
+
+	diagnostics := resourceKeycloakUserCredentialRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakUserCredential_otp(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user_credential.otp"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserCredential_otp(username, "my phone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserCredentialExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "otp"),
+					resource.TestCheckResourceAttr(resourceName, "user_label", "my phone"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"otp"},
+			},
+			{
+				Config: testKeycloakUserCredential_otp(username, "my other phone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserCredentialExists(resourceName),
+					testAccCheckKeycloakUserCredentialCount("keycloak_user.user", "otp", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserCredential_hashedPassword(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user_credential.password"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserCredential_hashedPassword(username, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserCredentialExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "password"),
+					// the initial password must have been replaced
+					testAccCheckKeycloakUserCredentialCount("keycloak_user.user", "password", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserCredential_existingPassword(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUserCredential_hashedPassword(username, false),
+				ExpectError: regexp.MustCompile("already has a password credential"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserCredentialExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId, userId, credentialId, err := parseUserCredentialId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		credential, err := keycloakClient.GetUserCredential(testCtx, realmId, userId, credentialId)
+		if err != nil {
+			return err
+		}
+
+		if credential == nil {
+			return fmt.Errorf("credential with id %s does not exist", credentialId)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUserCredentialCount(resourceName, credentialType string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		credentials, err := keycloakClient.GetUserCredentials(testCtx, user.RealmId, user.Id)
+		if err != nil {
+			return err
+		}
+
+		var count int
+		for _, credential := range credentials {
+			if credential.Type == credentialType {
+				count++
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("expected user %s to have %d %s credentials, got %d", user.Username, expected, credentialType, count)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserCredential_otp(username, userLabel string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user_credential" "otp" {
+	realm_id         = data.keycloak_realm.realm.id
+	user_id          = keycloak_user.user.id
+	user_label       = "%s"
+	replace_existing = true
+
+	otp {
+		secret = "%s"
+	}
+}
+	`, testAccRealm.Realm, username, userLabel, acctest.RandString(20))
+}
+
+func testKeycloakUserCredential_hashedPassword(username string, replaceExisting bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	initial_password {
+		value = "initial"
+	}
+}
+
+resource "keycloak_user_credential" "password" {
+	realm_id         = data.keycloak_realm.realm.id
+	user_id          = keycloak_user.user.id
+	replace_existing = %t
+
+	password {
+		hashed_salted_value = "ZdcPnfcQ+4rPl6OESjv6YMaXtpDUv8eYsg5vs5iDZ7I="
+		salt                = "c2FsdHNhbHRzYWx0c2FsdA=="
+		algorithm           = "pbkdf2-sha256"
+		hash_iterations     = 27500
+	}
+}
+	`, testAccRealm.Realm, username, replaceExisting)
+}
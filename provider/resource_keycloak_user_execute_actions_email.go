@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakUserExecuteActionsEmail() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserExecuteActionsEmailCreate,
+		ReadContext:   resourceKeycloakUserExecuteActionsEmailRead,
+		UpdateContext: resourceKeycloakUserExecuteActionsEmailUpdate,
+		DeleteContext: resourceKeycloakUserExecuteActionsEmailDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"actions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				MinItems:    1,
+				Description: "The required actions the user should perform, such as UPDATE_PASSWORD, CONFIGURE_TOTP or VERIFY_EMAIL.",
+			},
+			"lifespan": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of seconds the link within the email is valid for. Defaults to the realm's admin action lifespan.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The client id of the client that the user is redirected to once the actions have been performed.",
+			},
+			"redirect_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_id"},
+				Description:  "The uri the user is redirected to once the actions have been performed.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that cause the email to be sent again when they change.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakUserExecuteActionsEmailCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	actions := interfaceSliceToStringSlice(data.Get("actions").(*schema.Set).List())
+
+	err := keycloakClient.ExecuteUserActionsEmail(ctx, realmId, userId, actions, data.Get("lifespan").(int), data.Get("client_id").(string), data.Get("redirect_uri").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, userId))
+
+	return resourceKeycloakUserExecuteActionsEmailRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserExecuteActionsEmailRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// the email can't be read back, so the resource only lives as long as the user does
+	_, err := keycloakClient.GetUser(ctx, realmId, userId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserExecuteActionsEmailUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the email is only sent again when the triggers change, which replaces the resource. other changes are only stored in state
+	return resourceKeycloakUserExecuteActionsEmailRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserExecuteActionsEmailDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// an email that has been sent can't be revoked
+	return nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakUserExecuteActionsEmail_validateRequiredAction(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUserExecuteActionsEmail_basic(username, "DOES_NOT_EXIST"),
+				ExpectError: regexp.MustCompile("invalid required actions"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserExecuteActionsEmail_redirectUriRequiresClientId(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUserExecuteActionsEmail_redirectUriWithoutClientId(username),
+				ExpectError: regexp.MustCompile("all of `client_id,redirect_uri` must be specified"),
+			},
+		},
+	})
+}
+
+func testKeycloakUserExecuteActionsEmail_basic(username, action string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+	email    = "%s@fakedomain.com"
+}
+
+resource "keycloak_user_execute_actions_email" "onboarding" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+	actions  = ["%s"]
+	lifespan = 3600
+}
+	`, testAccRealm.Realm, username, username, action)
+}
+
+func testKeycloakUserExecuteActionsEmail_redirectUriWithoutClientId(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+	email    = "%s@fakedomain.com"
+}
+
+resource "keycloak_user_execute_actions_email" "onboarding" {
+	realm_id     = data.keycloak_realm.realm.id
+	user_id      = keycloak_user.user.id
+	actions      = ["UPDATE_PASSWORD"]
+	redirect_uri = "https://example.com/welcome"
+}
+	`, testAccRealm.Realm, username, username)
+}
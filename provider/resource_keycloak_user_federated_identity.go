@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakUserFederatedIdentity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserFederatedIdentityCreate,
+		ReadContext:   resourceKeycloakUserFederatedIdentityRead,
+		DeleteContext: resourceKeycloakUserFederatedIdentityDelete,
+		// This resource can be imported using {{realm}}/{{user_id}}/{{identity_provider}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakUserFederatedIdentityImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_provider": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"federated_user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the user within the identity provider.",
+			},
+			"federated_user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the user within the identity provider.",
+			},
+		},
+	}
+}
+
+func userFederatedIdentityId(realmId, userId, identityProvider string) string {
+	return fmt.Sprintf("%s/%s/%s", realmId, userId, identityProvider)
+}
+
+func getUserFederatedIdentity(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, userId, identityProvider string) (*keycloak.FederatedIdentity, error) {
+	federatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, federatedIdentity := range federatedIdentities {
+		if federatedIdentity.IdentityProvider == identityProvider {
+			return federatedIdentity, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceKeycloakUserFederatedIdentityCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	identityProvider := data.Get("identity_provider").(string)
+
+	err := keycloakClient.CreateUserFederatedIdentity(ctx, realmId, userId, &keycloak.FederatedIdentity{
+		IdentityProvider: identityProvider,
+		UserId:           data.Get("federated_user_id").(string),
+		UserName:         data.Get("federated_user_name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(userFederatedIdentityId(realmId, userId, identityProvider))
+
+	return resourceKeycloakUserFederatedIdentityRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserFederatedIdentityRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	identityProvider := data.Get("identity_provider").(string)
+
+	federatedIdentity, err := getUserFederatedIdentity(ctx, keycloakClient, realmId, userId, identityProvider)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if federatedIdentity == nil {
+		tflog.Warn(ctx, "Removing resource from state as the user is no longer linked to the identity provider", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+
+		return nil
+	}
+
+	data.Set("federated_user_id", federatedIdentity.UserId)
+	data.Set("federated_user_name", federatedIdentity.UserName)
+
+	return nil
+}
+
+func resourceKeycloakUserFederatedIdentityDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	identityProvider := data.Get("identity_provider").(string)
+
+	err := keycloakClient.DeleteUserFederatedIdentity(ctx, realmId, userId, identityProvider)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserFederatedIdentityImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{userId}}/{{identityProvider}}")
+	}
+
+	realmId := parts[0]
+	userId := parts[1]
+	identityProvider := parts[2]
+
+	federatedIdentity, err := getUserFederatedIdentity(ctx, keycloakClient, realmId, userId, identityProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if federatedIdentity == nil {
+		return nil, fmt.Errorf("user with id %s is not linked to identity provider %s", userId, identityProvider)
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("user_id", userId)
+	d.Set("identity_provider", identityProvider)
+	d.SetId(userFederatedIdentityId(realmId, userId, identityProvider))
+
+	diagnostics := resourceKeycloakUserFederatedIdentityRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakUserFederatedIdentity_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	federatedUserName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user_federated_identity.link"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(username, alias, federatedUserName),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, federatedUserName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the user resource ignores the link, so it must not be removed when the user is updated
+				Config: testKeycloakUserFederatedIdentity_updatedUser(username, alias, federatedUserName),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, federatedUserName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserFederatedIdentity_unlinkedOutsideOfTerraform(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	federatedUserName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user_federated_identity.link"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(username, alias, federatedUserName),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, federatedUserName),
+			},
+			{
+				PreConfig: func() {
+					user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, username)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.DeleteUserFederatedIdentity(testCtx, testAccRealm.Realm, user.Id, alias)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUserFederatedIdentity_basic(username, alias, federatedUserName),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, federatedUserName),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserFederatedIdentityExists(resourceName, federatedUserName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.Attributes["user_id"]
+		identityProvider := rs.Primary.Attributes["identity_provider"]
+
+		federatedIdentities, err := keycloakClient.GetUserFederatedIdentities(testCtx, realmId, userId)
+		if err != nil {
+			return err
+		}
+
+		for _, federatedIdentity := range federatedIdentities {
+			if federatedIdentity.IdentityProvider == identityProvider {
+				if federatedIdentity.UserName != federatedUserName {
+					return fmt.Errorf("expected federated user name %s, got %s", federatedUserName, federatedIdentity.UserName)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("user %s is not linked to identity provider %s", userId, identityProvider)
+	}
+}
+
+func testKeycloakUserFederatedIdentity_basic(username, alias, federatedUserName string) string {
+	return testKeycloakUserFederatedIdentity(username, "Bob", alias, federatedUserName)
+}
+
+func testKeycloakUserFederatedIdentity_updatedUser(username, alias, federatedUserName string) string {
+	return testKeycloakUserFederatedIdentity(username, "Robert", alias, federatedUserName)
+}
+
+func testKeycloakUserFederatedIdentity(username, firstName, alias, federatedUserName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "idp" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_user" "user" {
+	realm_id   = data.keycloak_realm.realm.id
+	username   = "%s"
+	first_name = "%s"
+}
+
+resource "keycloak_user_federated_identity" "link" {
+	realm_id            = data.keycloak_realm.realm.id
+	user_id             = keycloak_user.user.id
+	identity_provider   = keycloak_oidc_identity_provider.idp.alias
+	federated_user_id   = "%s-id"
+	federated_user_name = "%s"
+}
+	`, testAccRealm.Realm, alias, username, firstName, federatedUserName, federatedUserName)
+}
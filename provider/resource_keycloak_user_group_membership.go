@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakUserGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserGroupMembershipCreate,
+		ReadContext:   resourceKeycloakUserGroupMembershipRead,
+		DeleteContext: resourceKeycloakUserGroupMembershipDelete,
+		// This resource can be imported using {{realm}}/{{user_id}}/{{group_id}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakUserGroupMembershipImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func userGroupMembershipId(realmId, userId, groupId string) string {
+	return fmt.Sprintf("%s/%s/%s", realmId, userId, groupId)
+}
+
+func parseUserGroupMembershipId(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{userId}}/{{groupId}}")
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func resourceKeycloakUserGroupMembershipCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	groupId := data.Get("group_id").(string)
+
+	err := keycloakClient.AddUserToGroups(ctx, []string{groupId}, userId, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(userGroupMembershipId(realmId, userId, groupId))
+
+	return resourceKeycloakUserGroupMembershipRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserGroupMembershipRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId, userId, groupId, err := parseUserGroupMembershipId(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userGroups, err := keycloakClient.GetUserGroups(ctx, realmId, userId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	isMember := false
+	for _, group := range userGroups {
+		if group.Id == groupId {
+			isMember = true
+			break
+		}
+	}
+
+	if !isMember {
+		tflog.Warn(ctx, "Removing resource from state as the user is no longer a member of the group", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+
+		return nil
+	}
+
+	data.Set("realm_id", realmId)
+	data.Set("user_id", userId)
+	data.Set("group_id", groupId)
+
+	return nil
+}
+
+func resourceKeycloakUserGroupMembershipDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId, userId, groupId, err := parseUserGroupMembershipId(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.RemoveUserFromGroups(ctx, []string{groupId}, userId, realmId)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserGroupMembershipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId, userId, groupId, err := parseUserGroupMembershipId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = keycloakClient.GetGroup(ctx, realmId, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := resourceKeycloakUserGroupMembershipRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("user with id %s is not a member of the group with id %s", userId, groupId)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
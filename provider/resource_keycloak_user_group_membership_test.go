@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakUserGroupMembership_basic(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	otherGroupName := acctest.RandomWithPrefix("tf-acc")
+	userName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserGroupMembership_basic(groupName, otherGroupName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserIsMemberOfGroup("keycloak_user.user", "keycloak_group.group", true),
+					testAccCheckKeycloakUserIsMemberOfGroup("keycloak_user.user", "keycloak_group.other_group", true),
+				),
+			},
+			{
+				ResourceName:      "keycloak_user_group_membership.membership",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// removing the membership shouldn't touch the membership managed by keycloak_user_groups
+			{
+				Config: testKeycloakUserGroupMembership_noMembership(groupName, otherGroupName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserIsMemberOfGroup("keycloak_user.user", "keycloak_group.group", false),
+					testAccCheckKeycloakUserIsMemberOfGroup("keycloak_user.user", "keycloak_group.other_group", true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserGroupMembership_sharedGroup(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	firstUserName := acctest.RandomWithPrefix("tf-acc")
+	secondUserName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserGroupMembership_sharedGroup(groupName, firstUserName, secondUserName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserIsMemberOfGroup("keycloak_user.first_user", "keycloak_group.group", true),
+					testAccCheckKeycloakUserIsMemberOfGroup("keycloak_user.second_user", "keycloak_group.group", true),
+				),
+			},
+		},
+	})
+}
+
+// keycloak only returns the first 100 groups of a user by default, memberships beyond that must not be lost
+func TestAccKeycloakUserGroupMembership_manyGroups(t *testing.T) {
+	t.Parallel()
+
+	groupPrefix := acctest.RandomWithPrefix("tf-acc")
+	userName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserGroupMembership_manyGroups(groupPrefix, userName, 110),
+				Check:  testAccCheckKeycloakUserIsMemberOfGroup("keycloak_user.user", "keycloak_group.group.109", true),
+			},
+			{
+				Config:   testKeycloakUserGroupMembership_manyGroups(groupPrefix, userName, 110),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserGroupMembership_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	otherGroupName := acctest.RandomWithPrefix("tf-acc")
+	userName := acctest.RandomWithPrefix("tf-acc")
+
+	var realmId, userId, groupId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserGroupMembership_basic(groupName, otherGroupName, userName),
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["keycloak_user_group_membership.membership"]
+
+					realmId = rs.Primary.Attributes["realm_id"]
+					userId = rs.Primary.Attributes["user_id"]
+					groupId = rs.Primary.Attributes["group_id"]
+
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.RemoveUserFromGroups(testCtx, []string{groupId}, userId, realmId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUserGroupMembership_basic(groupName, otherGroupName, userName),
+				Check:  testAccCheckKeycloakUserIsMemberOfGroup("keycloak_user.user", "keycloak_group.group", true),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserGroupMembership_importNotMember(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	otherGroupName := acctest.RandomWithPrefix("tf-acc")
+	userName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserGroupMembership_noMembership(groupName, otherGroupName, userName),
+			},
+			{
+				Config:       testKeycloakUserGroupMembership_basic(groupName, otherGroupName, userName),
+				ResourceName: "keycloak_user_group_membership.membership",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					user := s.RootModule().Resources["keycloak_user.user"]
+					group := s.RootModule().Resources["keycloak_group.group"]
+
+					return fmt.Sprintf("%s/%s/%s", testAccRealm.Realm, user.Primary.ID, group.Primary.ID), nil
+				},
+				ExpectError: regexp.MustCompile("is not a member of the group"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserIsMemberOfGroup(userResourceName, groupResourceName string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, ok := s.RootModule().Resources[userResourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", userResourceName)
+		}
+
+		group, ok := s.RootModule().Resources[groupResourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", groupResourceName)
+		}
+
+		userGroups, err := keycloakClient.GetUserGroups(testCtx, user.Primary.Attributes["realm_id"], user.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		isMember := false
+		for _, userGroup := range userGroups {
+			if userGroup.Id == group.Primary.ID {
+				isMember = true
+			}
+		}
+
+		if isMember != expected {
+			return fmt.Errorf("expected membership of user %s in group %s to be %t, got %t", user.Primary.ID, group.Primary.ID, expected, isMember)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserGroupMembership_basic(groupName, otherGroupName, userName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_group" "other_group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user_groups" "other_groups" {
+	realm_id   = data.keycloak_realm.realm.id
+	user_id    = keycloak_user.user.id
+	exhaustive = false
+
+	group_ids = [
+		keycloak_group.other_group.id
+	]
+}
+
+resource "keycloak_user_group_membership" "membership" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+	group_id = keycloak_group.group.id
+}
+	`, testAccRealm.Realm, groupName, otherGroupName, userName)
+}
+
+func testKeycloakUserGroupMembership_noMembership(groupName, otherGroupName, userName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_group" "other_group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user_groups" "other_groups" {
+	realm_id   = data.keycloak_realm.realm.id
+	user_id    = keycloak_user.user.id
+	exhaustive = false
+
+	group_ids = [
+		keycloak_group.other_group.id
+	]
+}
+	`, testAccRealm.Realm, groupName, otherGroupName, userName)
+}
+
+func testKeycloakUserGroupMembership_sharedGroup(groupName, firstUserName, secondUserName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_user" "first_user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user" "second_user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user_group_membership" "first_membership" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.first_user.id
+	group_id = keycloak_group.group.id
+}
+
+resource "keycloak_user_group_membership" "second_membership" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.second_user.id
+	group_id = keycloak_group.group.id
+}
+	`, testAccRealm.Realm, groupName, firstUserName, secondUserName)
+}
+
+func testKeycloakUserGroupMembership_manyGroups(groupPrefix, userName string, count int) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	count    = %d
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-${count.index}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user_group_membership" "membership" {
+	count    = %d
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+	group_id = keycloak_group.group[count.index].id
+}
+	`, testAccRealm.Realm, count, groupPrefix, userName, count)
+}
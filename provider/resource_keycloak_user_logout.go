@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakUserLogout() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserLogoutCreate,
+		ReadContext:   resourceKeycloakUserLogoutRead,
+		DeleteContext: resourceKeycloakUserLogoutDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that cause the sessions of the user to be revoked again when they change.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakUserLogoutCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	err := keycloakClient.LogoutUser(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, userId))
+
+	return resourceKeycloakUserLogoutRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserLogoutRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// the logout can't be read back, so the resource only lives as long as the user does
+	_, err := keycloakClient.GetUser(ctx, realmId, userId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserLogoutDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// sessions that have been revoked can't be restored
+	return nil
+}
@@ -88,6 +88,47 @@ func TestAccKeycloakUser_withInitialPassword(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUser_withInitialHashedPassword(t *testing.T) {
+	username := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user.user"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_initialHashedPassword(username, clientId, "pbkdf2-sha256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserExists(resourceName),
+					// the password that was hashed outside of keycloak
+					testAccCheckKeycloakUserInitialPasswordLogin(username, "tf-acc-hashed-password", clientId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUser_validateInitialHashedPasswordAlgorithm(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUser_initialHashedPassword(username, clientId, "does-not-exist"),
+				ExpectError: regexp.MustCompile("validation error: password hashing algorithm \"does-not-exist\" does not exist on the server"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakUser_createAfterManualDestroy(t *testing.T) {
 	var user = &keycloak.User{}
 
@@ -586,6 +627,37 @@ resource "keycloak_user" "user" {
 	`, testAccRealm.Realm, userProfile, clientId, username, password, dependsOn)
 }
 
+func testKeycloakUser_initialHashedPassword(username, clientId, algorithm string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                     = data.keycloak_realm.realm.id
+	client_id                    = "%s"
+
+	name                         = "test client"
+	enabled                      = true
+
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	initial_hashed_password {
+		hashed_salted_value = "pT9BenwvidT/DBAB4jRfJVlKx3+Sq94JaHun/E4no+vcY5lLCZuhafPlM/i8xHc/yj47k0f/SrCyGnkTyRqZpw=="
+		salt                = "dGYtYWNjLXNhbHQtMTIzNA=="
+		algorithm           = "%s"
+		hash_iterations     = 27500
+	}
+}
+	`, testAccRealm.Realm, clientId, username, algorithm)
+}
+
 func testKeycloakUser_fromInterface(user *keycloak.User) string {
 	userProfile, dependsOn := userProfileIfKeycloakHasSupport("data.keycloak_realm.realm.id")
 	return fmt.Sprintf(`
@@ -47,6 +47,10 @@ func resourceKeycloakUsersPermissionsReconcile(ctx context.Context, data *schema
 
 	realmId := data.Get("realm_id").(string)
 
+	if err := checkAdminPermissionsV1(ctx, keycloakClient, realmId); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// the existence of this resource implies that it is enabled.
 	err := keycloakClient.EnableUsersPermissions(ctx, realmId)
 	if err != nil {
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -38,6 +39,23 @@ func TestAccKeycloakUsersPermission_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUsersPermission_adminPermissionsEnabled(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_2)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUsersPermission_adminPermissionsEnabled(realmName),
+				ExpectError: regexp.MustCompile("uses fine-grained admin permissions v2"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakUsersPermissionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		permissions, err := getUsersPermissionsFromState(s, resourceName)
@@ -281,3 +299,16 @@ resource "keycloak_openid_client_user_policy" "test2" {
 }
 	`, realmId, username, email)
 }
+
+func testKeycloakUsersPermission_adminPermissionsEnabled(realmId string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                     = "%s"
+	admin_permissions_enabled = true
+}
+
+resource "keycloak_users_permissions" "users_permissions" {
+	realm_id = keycloak_realm.realm.id
+}
+	`, realmId)
+}